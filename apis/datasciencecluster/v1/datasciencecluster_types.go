@@ -20,6 +20,7 @@ import (
 	"errors"
 	"reflect"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -166,3 +167,21 @@ func (d *DataScienceCluster) GetComponents() ([]components.ComponentInterface, e
 
 	return allComponents, nil
 }
+
+// GetComponentManagementState returns the management state of the component with the given name, e.g.
+// kueue.ComponentName, to be compared with operatorv1.Managed, operatorv1.Removed or operatorv1.Unmanaged.
+// The boolean is false when the DataScienceCluster has no such component.
+func (d *DataScienceCluster) GetComponentManagementState(name string) (operatorv1.ManagementState, bool) {
+	allComponents, err := d.GetComponents()
+	if err != nil {
+		return "", false
+	}
+
+	for _, component := range allComponents {
+		if component.GetComponentName() == name {
+			return component.GetManagementState(), true
+		}
+	}
+
+	return "", false
+}
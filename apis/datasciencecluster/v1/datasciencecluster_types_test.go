@@ -0,0 +1,35 @@
+package v1_test
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/kueue"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetComponentManagementState(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := &dscv1.DataScienceCluster{
+		Spec: dscv1.DataScienceClusterSpec{
+			Components: dscv1.Components{
+				Kueue: kueue.Kueue{
+					Component: components.Component{ManagementState: operatorv1.Managed},
+				},
+			},
+		},
+	}
+
+	state, found := dsc.GetComponentManagementState(kueue.ComponentName)
+	g.Expect(found).To(BeTrue())
+	g.Expect(state).To(Equal(operatorv1.Managed))
+
+	state, found = dsc.GetComponentManagementState("unknown")
+	g.Expect(found).To(BeFalse())
+	g.Expect(state).To(BeEmpty())
+}
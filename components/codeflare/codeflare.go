@@ -17,6 +17,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
 )
 
 var (
@@ -102,7 +103,7 @@ func (c *CodeFlare) ReconcileComponent(ctx context.Context,
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, //nolint:revive,nolintlint
 		CodeflarePath,
 		dscispec.ApplicationsNamespace,
-		ComponentName, enabled); err != nil {
+		ComponentName, enabled, plugins.CreateTargetNamespacePlugin(dscispec.Monitoring.Namespace)); err != nil {
 		return err
 	}
 	l.Info("apply manifests done")
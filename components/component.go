@@ -10,6 +10,7 @@ import (
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -48,6 +49,19 @@ func (c *Component) Cleanup(_ context.Context, _ client.Client, _ metav1.Object,
 	return nil
 }
 
+// DefaultResources returns the resource requirements set on the component containers which do not define any.
+// Components without recommended requirements keep the ones from their manifests.
+func (c *Component) DefaultResources() corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{}
+}
+
+// WatchedObjects returns the objects, identified by their kind, name and, when set, namespace, whose changes
+// trigger a reconcile of the DataScienceCluster in addition to the resources it owns, given the namespace the
+// components are deployed to. Components return nil when the owned resources are enough.
+func (c *Component) WatchedObjects(_ string) []client.Object {
+	return nil
+}
+
 // DevFlags defines list of fields that can be used by developers to test customizations. This is not recommended
 // to be used in production environment.
 // +kubebuilder:object:generate=true
@@ -57,6 +71,38 @@ type DevFlags struct {
 	Manifests []ManifestsConfig `json:"manifests,omitempty"`
 }
 
+// MergeDevFlags merges two sets of developer flags, e.g. DSC level and component level ones, into a new one.
+// Values set in override win field by field: manifests are merged by position, the non-empty fields of
+// each override entry replacing the ones of the base entry, and extra override entries being appended.
+// It returns nil when both inputs are nil.
+func MergeDevFlags(base, override *DevFlags) *DevFlags {
+	if base == nil {
+		return override.DeepCopy()
+	}
+	merged := base.DeepCopy()
+	if override == nil {
+		return merged
+	}
+
+	for i, manifest := range override.Manifests {
+		if i >= len(merged.Manifests) {
+			merged.Manifests = append(merged.Manifests, manifest)
+			continue
+		}
+		if manifest.URI != "" {
+			merged.Manifests[i].URI = manifest.URI
+		}
+		if manifest.ContextDir != "" {
+			merged.Manifests[i].ContextDir = manifest.ContextDir
+		}
+		if manifest.SourcePath != "" {
+			merged.Manifests[i].SourcePath = manifest.SourcePath
+		}
+	}
+
+	return merged
+}
+
 type ManifestsConfig struct {
 	// uri is the URI point to a git repo with tag/branch. e.g.  https://github.com/org/repo/tarball/<tag/branch>
 	// +optional
@@ -83,6 +129,8 @@ type ComponentInterface interface {
 	Cleanup(ctx context.Context, cli client.Client, owner metav1.Object, DSCISpec *dsciv1.DSCInitializationSpec) error
 	GetComponentName() string
 	GetManagementState() operatorv1.ManagementState
+	DefaultResources() corev1.ResourceRequirements
+	WatchedObjects(applicationsNamespace string) []client.Object
 	OverrideManifests(ctx context.Context, platform cluster.Platform) error
 	UpdatePrometheusConfig(cli client.Client, logger logr.Logger, enable bool, component string) error
 	ConfigComponentLogger(logger logr.Logger, component string, dscispec *dsciv1.DSCInitializationSpec) logr.Logger
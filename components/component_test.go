@@ -0,0 +1,46 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestMergeDevFlags(t *testing.T) {
+	g := NewWithT(t)
+
+	base := &components.DevFlags{
+		Manifests: []components.ManifestsConfig{
+			{
+				URI:        "https://github.com/org/repo/tarball/main",
+				ContextDir: "manifests",
+				SourcePath: "base",
+			},
+		},
+	}
+
+	g.Expect(components.MergeDevFlags(nil, nil)).To(BeNil())
+	g.Expect(components.MergeDevFlags(nil, base)).To(Equal(base))
+	g.Expect(components.MergeDevFlags(base, nil)).To(Equal(base))
+
+	override := &components.DevFlags{
+		Manifests: []components.ManifestsConfig{
+			{
+				SourcePath: "overlays/dev",
+			},
+		},
+	}
+
+	merged := components.MergeDevFlags(base, override)
+	g.Expect(merged.Manifests).To(Equal([]components.ManifestsConfig{
+		{
+			URI:        "https://github.com/org/repo/tarball/main",
+			ContextDir: "manifests",
+			SourcePath: "overlays/dev",
+		},
+	}))
+	// inputs are left untouched
+	g.Expect(base.Manifests[0].SourcePath).To(Equal("base"))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
 )
 
 var (
@@ -29,6 +30,11 @@ var _ components.ComponentInterface = (*Kueue)(nil)
 // +kubebuilder:object:generate=true
 type Kueue struct {
 	components.Component `json:""`
+
+	// List of job frameworks Kueue manages, e.g. "batch/job", "ray.io/rayjob" or "kubeflow.org/pytorchjob".
+	// Defaults to "batch/job" when empty.
+	// +optional
+	Integrations []string `json:"integrations,omitempty"`
 }
 
 func (k *Kueue) OverrideManifests(ctx context.Context, _ cluster.Platform) error {
@@ -76,7 +82,9 @@ func (k *Kueue) ReconcileComponent(ctx context.Context, cli client.Client, logge
 		}
 	}
 	// Deploy Kueue Operator
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path, dscispec.ApplicationsNamespace, ComponentName, enabled,
+		CreateConfigPlugin(k, dscispec.ApplicationsNamespace), k.CreateDefaultResourcesPlugin(),
+		plugins.CreateTargetNamespacePlugin(dscispec.Monitoring.Namespace)); err != nil {
 		return fmt.Errorf("failed to apply manifetss %s: %w", Path, err)
 	}
 	l.Info("apply manifests done")
@@ -0,0 +1,224 @@
+package kueue
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+)
+
+const (
+	// DefaultIntegration is the job framework managed by Kueue when none is requested.
+	DefaultIntegration = "batch/job"
+	// managerConfigName is the name of the rendered ConfigMap holding the Kueue manager configuration.
+	managerConfigName = "kueue-manager-config"
+	// managerConfigKey is the key of managerConfigName data holding the Configuration document.
+	managerConfigKey = "controller_manager_config.yaml"
+	// controllerManagerName is the name of the rendered Deployment of the Kueue controller manager.
+	controllerManagerName = "kueue-controller-manager"
+	// configAPIVersion is the API version of the Configuration document read by the Kueue manager.
+	configAPIVersion = "config.kueue.x-k8s.io/v1beta1"
+)
+
+// SupportedIntegrations lists the job frameworks Kueue can integrate with.
+var SupportedIntegrations = []string{
+	"batch/job",
+	"jobset.x-k8s.io/jobset",
+	"kubeflow.org/mpijob",
+	"kubeflow.org/mxjob",
+	"kubeflow.org/paddlejob",
+	"kubeflow.org/pytorchjob",
+	"kubeflow.org/tfjob",
+	"kubeflow.org/xgboostjob",
+	"pod",
+	"ray.io/raycluster",
+	"ray.io/rayjob",
+}
+
+// GetIntegrations returns the requested integrations or the default one when the list is empty.
+func (k *Kueue) GetIntegrations() []string {
+	if len(k.Integrations) == 0 {
+		return []string{DefaultIntegration}
+	}
+
+	return k.Integrations
+}
+
+// DefaultResources returns the resource requirements recommended for the Kueue controller manager.
+func (k *Kueue) DefaultResources() corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+			corev1.ResourceMemory: resource.MustParse("512Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+	}
+}
+
+// RenderConfig returns the ConfigMap read by the Kueue manager, in the given namespace, holding a Configuration
+// document with the requested integrations. It carries the component labels CreateAddLabelsPlugin sets on the
+// rendered resources, as it is added to them after that plugin ran.
+func (k *Kueue) RenderConfig(namespace string) (*corev1.ConfigMap, error) {
+	content, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": configAPIVersion,
+		"kind":       "Configuration",
+		"integrations": map[string]interface{}{
+			"frameworks": k.GetIntegrations(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      managerConfigName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				labels.ODH.Component(ComponentName): "true",
+				labels.K8SCommon.PartOf:             ComponentName,
+			},
+		},
+		Data: map[string]string{
+			managerConfigKey: string(content),
+		},
+	}, nil
+}
+
+// WatchedObjects returns the manager configuration deployed in the given namespace, so that its changes are
+// reconciled back to the configured integrations.
+func (k *Kueue) WatchedObjects(applicationsNamespace string) []client.Object {
+	return []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: managerConfigName, Namespace: applicationsNamespace}},
+	}
+}
+
+// CreateDefaultResourcesPlugin creates a plugin setting DefaultResources on the containers of the controller
+// manager which do not define any. The other workloads of the manifests are left untouched.
+func (k *Kueue) CreateDefaultResourcesPlugin() *plugins.ContainerResourcesPlugin {
+	return plugins.CreateContainerResourcesForTargetPlugin(k.DefaultResources(), &types.Selector{
+		ResId: resid.NewResId(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, controllerManagerName),
+	})
+}
+
+// ValidateIntegrations returns an error listing the integrations which are not supported by Kueue.
+func ValidateIntegrations(integrations []string) error {
+	var unknown []string
+	for _, integration := range integrations {
+		if !slices.Contains(SupportedIntegrations, integration) {
+			unknown = append(unknown, integration)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unsupported Kueue integrations %s, supported integrations are: %s",
+			strings.Join(unknown, ", "), strings.Join(SupportedIntegrations, ", "))
+	}
+
+	return nil
+}
+
+// IntegrationsPlugin sets the job frameworks managed by Kueue in the rendered manager configuration.
+type IntegrationsPlugin struct {
+	Integrations []string
+}
+
+var _ resmap.Transformer = &IntegrationsPlugin{}
+
+// CreateIntegrationsPlugin creates a plugin configuring the given integrations.
+func CreateIntegrationsPlugin(integrations []string) *IntegrationsPlugin {
+	return &IntegrationsPlugin{
+		Integrations: integrations,
+	}
+}
+
+// Transform replaces "integrations.frameworks" in the Kueue manager configuration, if rendered.
+func (p *IntegrationsPlugin) Transform(m resmap.ResMap) error {
+	for _, res := range m.Resources() {
+		if res.GetKind() != "ConfigMap" || res.GetName() != managerConfigName {
+			continue
+		}
+
+		data := res.GetDataMap()
+		config := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(data[managerConfigKey]), &config); err != nil {
+			return fmt.Errorf("failed parsing %s from ConfigMap %s: %w", managerConfigKey, managerConfigName, err)
+		}
+
+		integrations, ok := config["integrations"].(map[string]interface{})
+		if !ok {
+			integrations = map[string]interface{}{}
+		}
+		integrations["frameworks"] = p.Integrations
+		config["integrations"] = integrations
+
+		content, err := yaml.Marshal(config)
+		if err != nil {
+			return err
+		}
+		data[managerConfigKey] = string(content)
+		res.SetDataMap(data)
+	}
+
+	return nil
+}
+
+// ConfigPlugin configures the integrations of Kueue in the manager configuration rendered from the manifests,
+// or adds the ConfigMap returned by RenderConfig to the resources when the manifests do not render it.
+type ConfigPlugin struct {
+	Kueue     *Kueue
+	Namespace string
+}
+
+var _ resmap.Transformer = &ConfigPlugin{}
+
+// CreateConfigPlugin creates a plugin configuring the given Kueue component, deployed in the given namespace.
+func CreateConfigPlugin(k *Kueue, namespace string) *ConfigPlugin {
+	return &ConfigPlugin{
+		Kueue:     k,
+		Namespace: namespace,
+	}
+}
+
+// Transform updates or appends the Kueue manager configuration of the ResMap.
+func (p *ConfigPlugin) Transform(m resmap.ResMap) error {
+	for _, res := range m.Resources() {
+		if res.GetKind() == "ConfigMap" && res.GetName() == managerConfigName {
+			return CreateIntegrationsPlugin(p.Kueue.GetIntegrations()).Transform(m)
+		}
+	}
+
+	configMap, err := p.Kueue.RenderConfig(p.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed rendering ConfigMap %s: %w", managerConfigName, err)
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(configMap)
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+
+	return m.Append(provider.NewDefaultDepProvider().GetResourceFactory().FromMap(content))
+}
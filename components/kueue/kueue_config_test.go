@@ -0,0 +1,186 @@
+package kueue_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/kueue"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/ray"
+
+	. "github.com/onsi/gomega"
+)
+
+const managerConfig = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kueue-manager-config
+data:
+  controller_manager_config.yaml: |
+    apiVersion: config.kueue.x-k8s.io/v1beta1
+    kind: Configuration
+    manageJobsWithoutQueueName: false
+    integrations:
+      frameworks:
+      - "batch/job"
+      - "kubeflow.org/mpijob"
+`
+
+func renderedFrameworks(g *WithT, resMap resmap.ResMap) []interface{} {
+	res, err := resMap.GetById(resid.NewResId(resid.Gvk{Version: "v1", Kind: "ConfigMap"}, "kueue-manager-config"))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	config := map[string]interface{}{}
+	g.Expect(yaml.Unmarshal([]byte(res.GetDataMap()["controller_manager_config.yaml"]), &config)).To(Succeed())
+	g.Expect(config).To(HaveKeyWithValue("apiVersion", "config.kueue.x-k8s.io/v1beta1"))
+	g.Expect(config).To(HaveKeyWithValue("manageJobsWithoutQueueName", false))
+
+	return config["integrations"].(map[string]interface{})["frameworks"].([]interface{})
+}
+
+func TestIntegrationsPlugin(t *testing.T) {
+	g := NewWithT(t)
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes([]byte(managerConfig))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	k := &kueue.Kueue{Integrations: []string{"ray.io/rayjob", "kubeflow.org/pytorchjob"}}
+	g.Expect(kueue.CreateIntegrationsPlugin(k.GetIntegrations()).Transform(resMap)).To(Succeed())
+
+	g.Expect(renderedFrameworks(g, resMap)).To(Equal([]interface{}{"ray.io/rayjob", "kubeflow.org/pytorchjob"}))
+}
+
+func TestIntegrationsPluginDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes([]byte(managerConfig))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	k := &kueue.Kueue{}
+	g.Expect(kueue.CreateIntegrationsPlugin(k.GetIntegrations()).Transform(resMap)).To(Succeed())
+
+	g.Expect(renderedFrameworks(g, resMap)).To(Equal([]interface{}{"batch/job"}))
+}
+
+func TestValidateIntegrations(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(kueue.ValidateIntegrations([]string{"batch/job", "ray.io/rayjob"})).To(Succeed())
+	g.Expect(kueue.ValidateIntegrations([]string{"batch/job", "example.com/unknownjob"})).To(
+		MatchError(ContainSubstring("example.com/unknownjob")))
+}
+
+const controllerManager = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: kueue-controller-manager
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: kueue:latest
+`
+
+func TestDefaultResources(t *testing.T) {
+	g := NewWithT(t)
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes([]byte(controllerManager + `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: kueue-visibility-server
+spec:
+  template:
+    spec:
+      containers:
+      - name: server
+        image: kueue:latest
+`))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	k := &kueue.Kueue{}
+	g.Expect(k.CreateDefaultResourcesPlugin().Transform(resMap)).To(Succeed())
+
+	containers := func(name string) []corev1.Container {
+		res, err := resMap.GetById(resid.NewResId(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, name))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		deployment := appsv1.Deployment{}
+		g.Expect(yaml.Unmarshal([]byte(res.MustYaml()), &deployment)).To(Succeed())
+		g.Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+
+		return deployment.Spec.Template.Spec.Containers
+	}
+
+	g.Expect(containers("kueue-controller-manager")[0].Resources).To(Equal(k.DefaultResources()))
+	g.Expect(containers("kueue-visibility-server")[0].Resources).To(Equal(corev1.ResourceRequirements{}))
+}
+
+func TestRenderConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	k := &kueue.Kueue{Integrations: []string{"ray.io/rayjob", "kubeflow.org/pytorchjob"}}
+	configMap, err := k.RenderConfig("opendatahub")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(configMap.Name).To(Equal("kueue-manager-config"))
+	g.Expect(configMap.Namespace).To(Equal("opendatahub"))
+	g.Expect(configMap.Labels).To(Equal(map[string]string{
+		"app.opendatahub.io/kueue":  "true",
+		"app.kubernetes.io/part-of": "kueue",
+	}))
+	g.Expect(configMap.Data).To(HaveKeyWithValue("controller_manager_config.yaml", `apiVersion: config.kueue.x-k8s.io/v1beta1
+integrations:
+  frameworks:
+  - ray.io/rayjob
+  - kubeflow.org/pytorchjob
+kind: Configuration
+`))
+}
+
+func TestConfigPluginAddsMissingConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes([]byte(controllerManager))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	k := &kueue.Kueue{Integrations: []string{"ray.io/rayjob"}}
+	g.Expect(kueue.CreateConfigPlugin(k, "opendatahub").Transform(resMap)).To(Succeed())
+
+	res, err := resMap.GetById(resid.NewResIdWithNamespace(resid.Gvk{Version: "v1", Kind: "ConfigMap"}, "kueue-manager-config", "opendatahub"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.GetLabels()).To(HaveKeyWithValue("app.opendatahub.io/kueue", "true"))
+}
+
+func TestConfigPluginUpdatesRenderedConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes([]byte(managerConfig))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	k := &kueue.Kueue{Integrations: []string{"ray.io/rayjob"}}
+	g.Expect(kueue.CreateConfigPlugin(k, "opendatahub").Transform(resMap)).To(Succeed())
+
+	g.Expect(resMap.Resources()).To(HaveLen(1))
+	g.Expect(renderedFrameworks(g, resMap)).To(Equal([]interface{}{"ray.io/rayjob"}))
+}
+
+func TestWatchedObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	watched := (&kueue.Kueue{}).WatchedObjects("opendatahub")
+	g.Expect(watched).To(HaveLen(1))
+	g.Expect(watched[0]).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+	g.Expect(watched[0].GetName()).To(Equal("kueue-manager-config"))
+	g.Expect(watched[0].GetNamespace()).To(Equal("opendatahub"))
+
+	g.Expect((&ray.Ray{}).WatchedObjects("opendatahub")).To(BeNil())
+}
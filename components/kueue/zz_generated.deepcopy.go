@@ -26,6 +26,11 @@ import ()
 func (in *Kueue) DeepCopyInto(out *Kueue) {
 	*out = *in
 	in.Component.DeepCopyInto(&out.Component)
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kueue.
@@ -18,6 +18,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
 )
 
 var (
@@ -140,7 +141,7 @@ func (w *Workbenches) ReconcileComponent(ctx context.Context, cli client.Client,
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
 		notebookControllerPath,
 		dscispec.ApplicationsNamespace,
-		ComponentName, enabled); err != nil {
+		ComponentName, enabled, plugins.CreateTargetNamespacePlugin(dscispec.Monitoring.Namespace)); err != nil {
 		return fmt.Errorf("failed to apply manifetss %s: %w", notebookControllerPath, err)
 	}
 	l.WithValues("Path", notebookControllerPath).Info("apply manifests done notebook controller done")
@@ -148,7 +149,7 @@ func (w *Workbenches) ReconcileComponent(ctx context.Context, cli client.Client,
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
 		kfnotebookControllerPath,
 		dscispec.ApplicationsNamespace,
-		ComponentName, enabled); err != nil {
+		ComponentName, enabled, plugins.CreateTargetNamespacePlugin(dscispec.Monitoring.Namespace)); err != nil {
 		return fmt.Errorf("failed to apply manifetss %s: %w", kfnotebookControllerPath, err)
 	}
 	l.WithValues("Path", kfnotebookControllerPath).Info("apply manifests done kf-notebook controller done")
@@ -156,7 +157,7 @@ func (w *Workbenches) ReconcileComponent(ctx context.Context, cli client.Client,
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
 		notebookImagesPath,
 		dscispec.ApplicationsNamespace,
-		ComponentName, enabled); err != nil {
+		ComponentName, enabled, plugins.CreateTargetNamespacePlugin(dscispec.Monitoring.Namespace)); err != nil {
 		return err
 	}
 	l.WithValues("Path", notebookImagesPath).Info("apply manifests done notebook image done")
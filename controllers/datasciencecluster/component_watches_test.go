@@ -0,0 +1,129 @@
+package datasciencecluster_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	buildv1 "github.com/openshift/api/build/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	dscctrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/datasciencecluster"
+
+	. "github.com/onsi/gomega"
+)
+
+// reconcileLog collects the messages the reconciler logs.
+type reconcileLog struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (l *reconcileLog) add(_, args string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, args)
+}
+
+// reconciles returns the number of reconciles of the default DataScienceCluster logged so far, the reconciles
+// failing against the empty fake cluster are retried.
+func (l *reconcileLog) reconciles() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := 0
+	for _, message := range l.messages {
+		if strings.Contains(message, "Reconciling DataScienceCluster resources") && strings.Contains(message, "default-dsc") {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestComponentWatchedConfigMapDataChangeTriggersReconcile(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("ODH_PLATFORM_TYPE", "OpenDataHub")
+
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme, dscv1.AddToScheme, dsciv1.AddToScheme, apiextensionsv1.AddToScheme,
+		apiregistrationv1.AddToScheme, imagev1.Install, buildv1.Install, operatorv1.Install,
+	} {
+		g.Expect(addToScheme(scheme)).To(Succeed())
+	}
+
+	// the manager runs against fake informers and a fake client, without any API server
+	informers := &informertest.FakeInformers{Scheme: scheme}
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "https://127.0.0.1:0"}, ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+		MapperProvider: func(*rest.Config, *http.Client) (meta.RESTMapper, error) {
+			return meta.NewDefaultRESTMapper(nil), nil
+		},
+		NewCache: func(*rest.Config, cache.Options) (cache.Cache, error) {
+			return informers, nil
+		},
+		NewClient: func(*rest.Config, client.Options) (client.Client, error) {
+			return fake.NewClientBuilder().WithScheme(scheme).Build(), nil
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	log := &reconcileLog{}
+	r := &dscctrl.DataScienceClusterReconciler{
+		Client: mgr.GetClient(),
+		Scheme: scheme,
+		Log:    funcr.New(log.add, funcr.Options{}),
+		DataScienceCluster: &dscctrl.DataScienceClusterConfig{
+			DSCISpec: &dsciv1.DSCInitializationSpec{ApplicationsNamespace: "opendatahub"},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	g.Expect(r.SetupWithManager(ctx, mgr)).To(Succeed())
+	go func() {
+		_ = mgr.Start(ctx)
+	}()
+	g.Expect(mgr.GetCache().WaitForCacheSync(ctx)).To(BeTrue())
+
+	configMapInformer, err := informers.FakeInformerFor(ctx, &corev1.ConfigMap{})
+	g.Expect(err).NotTo(HaveOccurred())
+	configMap := func(namespace, resourceVersion, value string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kueue-manager-config", Namespace: namespace, ResourceVersion: resourceVersion},
+			Data:       map[string]string{"controller_manager_config.yaml": value},
+		}
+	}
+
+	// updates not changing the data and ConfigMaps of other namespaces are filtered out
+	configMapInformer.Update(configMap("opendatahub", "1", "initial"), configMap("opendatahub", "2", "initial"))
+	configMapInformer.Update(configMap("other", "1", "initial"), configMap("other", "2", "changed"))
+	g.Consistently(log.reconciles, 500*time.Millisecond).Should(BeZero())
+
+	configMapInformer.Update(configMap("opendatahub", "2", "initial"), configMap("opendatahub", "3", "changed"))
+	g.Eventually(log.reconciles, 5*time.Second).ShouldNot(BeZero())
+}
@@ -35,6 +35,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -456,82 +457,147 @@ var modelMeshGeneralPredicates = predicate.Funcs{
 	},
 }
 
+// generationOrLabelChangedPredicate prevents meaningless reconciliations from being triggered. It is set on each
+// watch rather than with WithEventFilter(), which would also drop the content changes of the component watched
+// objects, e.g. the data of a ConfigMap, which has no generation.
+var generationOrLabelChangedPredicate = predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})
+
+// generationOrFilteredLabelChangedPredicate passes the generation changes, and the label changes labelPredicate
+// lets through, e.g. to ignore the label updates of the resources modelmesh and kserve share.
+func generationOrFilteredLabelChangedPredicate(labelPredicate predicate.Predicate) predicate.Predicate {
+	return predicate.Or(predicate.GenerationChangedPredicate{}, predicate.And(predicate.LabelChangedPredicate{}, labelPredicate))
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *DataScienceClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&dscv1.DataScienceCluster{}).
-		Owns(&corev1.Namespace{}).
-		Owns(&corev1.Secret{}).
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&dscv1.DataScienceCluster{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
+		Owns(&corev1.Namespace{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
+		Owns(&corev1.Secret{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
 		Owns(
 			&corev1.ConfigMap{},
-			builder.WithPredicates(configMapPredicates),
+			builder.WithPredicates(generationOrLabelChangedPredicate, configMapPredicates),
 		).
 		Owns(
 			&networkingv1.NetworkPolicy{},
-			builder.WithPredicates(networkpolicyPredicates),
+			builder.WithPredicates(generationOrLabelChangedPredicate, networkpolicyPredicates),
 		).
 		Owns(
 			&rbacv1.Role{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, modelMeshRolePredicates))).
+			builder.WithPredicates(generationOrFilteredLabelChangedPredicate(modelMeshRolePredicates))).
 		Owns(
 			&rbacv1.RoleBinding{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, modelMeshRBPredicates))).
+			builder.WithPredicates(generationOrFilteredLabelChangedPredicate(modelMeshRBPredicates))).
 		Owns(
 			&rbacv1.ClusterRole{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, modelMeshRolePredicates))).
+			builder.WithPredicates(generationOrFilteredLabelChangedPredicate(modelMeshRolePredicates))).
 		Owns(
 			&rbacv1.ClusterRoleBinding{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, modelMeshRBPredicates))).
+			builder.WithPredicates(generationOrFilteredLabelChangedPredicate(modelMeshRBPredicates))).
 		Owns(
 			&appsv1.Deployment{},
-			builder.WithPredicates(componentDeploymentPredicates)).
-		Owns(&corev1.PersistentVolumeClaim{}).
+			builder.WithPredicates(generationOrLabelChangedPredicate, componentDeploymentPredicates)).
+		Owns(&corev1.PersistentVolumeClaim{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
 		Owns(
 			&corev1.Service{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, modelMeshGeneralPredicates))).
-		Owns(&appsv1.StatefulSet{}).
-		Owns(&imagev1.ImageStream{}).
-		Owns(&buildv1.BuildConfig{}).
-		Owns(&apiregistrationv1.APIService{}).
-		Owns(&operatorv1.IngressController{}).
-		Owns(&admissionregistrationv1.MutatingWebhookConfiguration{}).
+			builder.WithPredicates(generationOrFilteredLabelChangedPredicate(modelMeshGeneralPredicates))).
+		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
+		Owns(&imagev1.ImageStream{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
+		Owns(&buildv1.BuildConfig{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
+		Owns(&apiregistrationv1.APIService{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
+		Owns(&operatorv1.IngressController{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
+		Owns(&admissionregistrationv1.MutatingWebhookConfiguration{}, builder.WithPredicates(generationOrLabelChangedPredicate)).
 		Owns(
 			&admissionregistrationv1.ValidatingWebhookConfiguration{},
-			builder.WithPredicates(modelMeshwebhookPredicates),
+			builder.WithPredicates(generationOrLabelChangedPredicate, modelMeshwebhookPredicates),
 		).
 		Owns(
 			&corev1.ServiceAccount{},
-			builder.WithPredicates(saPredicates),
+			builder.WithPredicates(generationOrLabelChangedPredicate, saPredicates),
 		).
 		Watches(
 			&dsciv1.DSCInitialization{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
 				return r.watchDataScienceClusterForDSCI(ctx, a)
-			},
-			)).
+			}),
+			builder.WithPredicates(generationOrLabelChangedPredicate)).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
 				return r.watchDataScienceClusterResources(ctx, a)
 			}),
-			builder.WithPredicates(configMapPredicates),
+			builder.WithPredicates(generationOrLabelChangedPredicate, configMapPredicates),
 		).
 		Watches(
 			&apiextensionsv1.CustomResourceDefinition{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
 				return r.watchDataScienceClusterResources(ctx, a)
 			}),
-			builder.WithPredicates(argoWorkflowCRDPredicates),
+			builder.WithPredicates(generationOrLabelChangedPredicate, argoWorkflowCRDPredicates),
 		).
 		Watches(
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
 				return r.watchDefaultIngressSecret(ctx, a)
 			}),
-			builder.WithPredicates(defaultIngressCertSecretPredicates)).
-		// this predicates prevents meaningless reconciliations from being triggered
-		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})).
-		Complete(r)
+			builder.WithPredicates(generationOrLabelChangedPredicate, defaultIngressCertSecretPredicates))
+
+	// Watch the objects components need on top of the resources owned by the DataScienceCluster
+	allComponents, err := (&dscv1.DataScienceCluster{}).GetComponents()
+	if err != nil {
+		return err
+	}
+	for _, component := range allComponents {
+		for _, obj := range component.WatchedObjects(r.DataScienceCluster.DSCISpec.ApplicationsNamespace) {
+			b = b.Watches(
+				obj,
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+					return r.watchComponentObjects(ctx, a)
+				}),
+				builder.WithPredicates(watchedObjectPredicate(obj), watchedObjectChangedPredicate))
+		}
+	}
+
+	return b.Complete(r)
+}
+
+func (r *DataScienceClusterReconciler) watchComponentObjects(ctx context.Context, _ client.Object) []reconcile.Request {
+	requestName, err := r.getRequestName(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: requestName},
+	}}
+}
+
+// watchedObjectChangedPredicate passes the updates of a component watched object changing its generation, its
+// labels or its content, i.e. anything but its metadata and status.
+var watchedObjectChangedPredicate = predicate.Or(generationOrLabelChangedPredicate, predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return !equality.Semantic.DeepEqual(objectContent(e.ObjectOld), objectContent(e.ObjectNew))
+	},
+})
+
+// objectContent returns the fields of obj besides its metadata and status, or nil if it cannot be converted.
+func objectContent(obj client.Object) map[string]interface{} {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil
+	}
+	delete(content, "metadata")
+	delete(content, "status")
+
+	return content
+}
+
+// watchedObjectPredicate filters the events of the objects with the name and, when set, the namespace of watched.
+func watchedObjectPredicate(watched client.Object) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetName() == watched.GetName() &&
+			(watched.GetNamespace() == "" || obj.GetNamespace() == watched.GetNamespace())
+	})
 }
 
 func (r *DataScienceClusterReconciler) watchDataScienceClusterForDSCI(ctx context.Context, a client.Object) []reconcile.Request {
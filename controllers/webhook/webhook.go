@@ -34,13 +34,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/kueue"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/modelregistry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 )
 
 var log = ctrl.Log.WithName("rhoai-controller-webhook")
 
-//+kubebuilder:webhook:path=/validate-opendatahub-io-v1,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io;dscinitialization.opendatahub.io,resources=datascienceclusters;dscinitializations,verbs=create;delete,versions=v1,name=operator.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-opendatahub-io-v1,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io;dscinitialization.opendatahub.io,resources=datascienceclusters;dscinitializations,verbs=create;update;delete,versions=v1,name=operator.opendatahub.io,admissionReviewVersions=v1
 //nolint:lll
 
 type OpenDataHubValidatingWebhook struct {
@@ -118,6 +119,24 @@ func (w *OpenDataHubValidatingWebhook) checkDeletion(ctx context.Context, req ad
 		fmt.Sprintln("Cannot delete DSCI object when DSC object still exists"))
 }
 
+// checkComponentsConfig validates the component configuration of a DataScienceCluster being created or updated.
+func (w *OpenDataHubValidatingWebhook) checkComponentsConfig(req admission.Request) admission.Response {
+	if req.Kind.Kind != "DataScienceCluster" {
+		return admission.Allowed("")
+	}
+
+	dsc := &dscv1.DataScienceCluster{}
+	if err := w.Decoder.Decode(req, dsc); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := kueue.ValidateIntegrations(dsc.Spec.Components.Kueue.Integrations); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
 func (w *OpenDataHubValidatingWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
 	var resp admission.Response
 	resp.Allowed = true // initialize Allowed to be true in case Operation falls into "default" case
@@ -125,6 +144,11 @@ func (w *OpenDataHubValidatingWebhook) Handle(ctx context.Context, req admission
 	switch req.Operation {
 	case admissionv1.Create:
 		resp = w.checkDupCreation(ctx, req)
+		if resp.Allowed {
+			resp = w.checkComponentsConfig(req)
+		}
+	case admissionv1.Update:
+		resp = w.checkComponentsConfig(req)
 	case admissionv1.Delete:
 		resp = w.checkDeletion(ctx, req)
 	default: // for other operations by default it is admission.Allowed("")
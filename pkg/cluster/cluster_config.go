@@ -9,6 +9,7 @@ import (
 
 	"github.com/blang/semver/v4"
 	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	"github.com/operator-framework/api/pkg/lib/version"
 	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -16,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
@@ -86,6 +88,16 @@ func GetClusterServiceVersion(ctx context.Context, c client.Client, namespace st
 
 type Platform string
 
+// OperatorName returns the name of the operator installed on the given platform, e.g. for the
+// "app.kubernetes.io/managed-by" label of the resources it manages.
+func OperatorName(platform Platform) string {
+	if platform == SelfManagedRhoai || platform == ManagedRhoai {
+		return "rhods-operator"
+	}
+
+	return "opendatahub-operator"
+}
+
 // detectSelfManaged detects if it is Self Managed Rhoai or OpenDataHub.
 func detectSelfManaged(ctx context.Context, cli client.Client) (Platform, error) {
 	variants := map[string]Platform{
@@ -201,3 +213,20 @@ func IsDefaultAuthMethod(ctx context.Context, cli client.Client) (bool, error) {
 	// we only create userGroups for "IntegratedOAuth" or "" and leave other or new supported type value in the future
 	return authenticationobj.Spec.Type == configv1.AuthenticationTypeIntegratedOAuth || authenticationobj.Spec.Type == "", nil
 }
+
+// IsOpenShift returns true if the cluster serves the OpenShift route.openshift.io API group,
+// which allows to choose between OpenShift (i.e. Routes) and vanilla Kubernetes (i.e. Ingress) manifests.
+func IsOpenShift(cli discovery.DiscoveryInterface) (bool, error) {
+	groups, err := cli.ServerGroups()
+	if err != nil {
+		return false, fmt.Errorf("failed fetching server API groups: %w", err)
+	}
+
+	for _, group := range groups.Groups {
+		if group.Name == routev1.GroupName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
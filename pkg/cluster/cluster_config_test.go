@@ -0,0 +1,51 @@
+package cluster_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIsOpenShift(t *testing.T) {
+	g := NewWithT(t)
+
+	discoveryCli := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{
+		Resources: []*metav1.APIResourceList{
+			{GroupVersion: "apps/v1"},
+			{GroupVersion: "route.openshift.io/v1"},
+		},
+	}}
+
+	isOpenShift, err := cluster.IsOpenShift(discoveryCli)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(isOpenShift).To(BeTrue())
+}
+
+func TestIsNotOpenShift(t *testing.T) {
+	g := NewWithT(t)
+
+	discoveryCli := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{
+		Resources: []*metav1.APIResourceList{
+			{GroupVersion: "apps/v1"},
+			{GroupVersion: "networking.k8s.io/v1"},
+		},
+	}}
+
+	isOpenShift, err := cluster.IsOpenShift(discoveryCli)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(isOpenShift).To(BeFalse())
+}
+
+func TestOperatorName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(cluster.OperatorName(cluster.OpenDataHub)).To(Equal("opendatahub-operator"))
+	g.Expect(cluster.OperatorName(cluster.SelfManagedRhoai)).To(Equal("rhods-operator"))
+	g.Expect(cluster.OperatorName(cluster.ManagedRhoai)).To(Equal("rhods-operator"))
+}
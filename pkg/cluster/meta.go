@@ -2,10 +2,14 @@ package cluster
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 // MetaOptions allows to add additional settings for the object being created through a chain
@@ -43,6 +47,11 @@ func WithLabels(labels ...string) MetaOptions {
 		if err != nil {
 			return fmt.Errorf("failed unable to set labels: %w", err)
 		}
+		for key, value := range labelsMap {
+			if errs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(value)...); len(errs) > 0 {
+				return fmt.Errorf("failed unable to set label %s=%s: %s", key, value, strings.Join(errs, "; "))
+			}
+		}
 
 		obj.SetLabels(labelsMap)
 
@@ -50,6 +59,29 @@ func WithLabels(labels ...string) MetaOptions {
 	}
 }
 
+// WithPodSecurity sets the Pod Security Admission enforce, audit and warn labels of a namespace to the given level,
+// one of "privileged", "baseline" or "restricted". Other labels of the namespace are kept.
+func WithPodSecurity(level string) MetaOptions {
+	return func(obj metav1.Object) error {
+		switch level {
+		case "privileged", "baseline", "restricted":
+		default:
+			return fmt.Errorf("invalid pod security level %q, must be one of privileged, baseline or restricted", level)
+		}
+
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = map[string]string{}
+		}
+		for _, label := range []string{labels.SecurityEnforce, labels.SecurityAudit, labels.SecurityWarn} {
+			objLabels[label] = level
+		}
+		obj.SetLabels(objLabels)
+
+		return nil
+	}
+}
+
 func InNamespace(ns string) MetaOptions {
 	return func(obj metav1.Object) error {
 		obj.SetNamespace(ns)
@@ -63,6 +95,11 @@ func WithAnnotations(annotationKeyValue ...string) MetaOptions {
 		if err != nil {
 			return fmt.Errorf("failed to set labels: %w", err)
 		}
+		for key := range annotationsMap {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf("failed to set annotation %s: %s", key, strings.Join(errs, "; "))
+			}
+		}
 
 		obj.SetAnnotations(annotationsMap)
 
@@ -0,0 +1,62 @@
+package cluster_test
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCreateNamespaceWithPodSecurity(t *testing.T) {
+	g := NewWithT(t)
+
+	cli := fake.NewClientBuilder().Build()
+
+	_, err := cluster.CreateNamespace(context.Background(), cli, "restricted-ns",
+		cluster.WithLabels(labels.ODH.OwnedNamespace, "true"),
+		cluster.WithPodSecurity("restricted"))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	namespace := &corev1.Namespace{}
+	g.Expect(cli.Get(context.Background(), client.ObjectKey{Name: "restricted-ns"}, namespace)).To(Succeed())
+	g.Expect(namespace.GetLabels()).To(Equal(map[string]string{
+		labels.ODH.OwnedNamespace: "true",
+		labels.SecurityEnforce:    "restricted",
+		labels.SecurityAudit:      "restricted",
+		labels.SecurityWarn:       "restricted",
+	}))
+}
+
+func TestWithPodSecurityInvalidLevel(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(cluster.ApplyMetaOptions(&corev1.Namespace{}, cluster.WithPodSecurity("strict"))).
+		To(MatchError(ContainSubstring("strict")))
+}
+
+func TestWithLabelsInvalidKey(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(cluster.ApplyMetaOptions(&corev1.Namespace{}, cluster.WithLabels("team/ml/core", "true"))).
+		To(MatchError(ContainSubstring("team/ml/core")))
+	g.Expect(cluster.ApplyMetaOptions(&corev1.Namespace{}, cluster.WithLabels("team", "machine learning"))).
+		To(MatchError(ContainSubstring("machine learning")))
+	g.Expect(cluster.ApplyMetaOptions(&corev1.Namespace{}, cluster.WithLabels(labels.K8SCommon.PartOf, "kueue"))).
+		To(Succeed())
+}
+
+func TestWithAnnotationsInvalidKey(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(cluster.ApplyMetaOptions(&corev1.Namespace{}, cluster.WithAnnotations("-invalid", "any value"))).
+		To(MatchError(ContainSubstring("-invalid")))
+	g.Expect(cluster.ApplyMetaOptions(&corev1.Namespace{}, cluster.WithAnnotations("opendatahub.io/managed", "any value"))).
+		To(Succeed())
+}
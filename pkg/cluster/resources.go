@@ -12,6 +12,8 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -201,6 +203,53 @@ func WaitForDeploymentAvailable(ctx context.Context, c client.Client, componentN
 	})
 }
 
+// ResourceCondition reports whether the fetched resource reached the state a caller is waiting for.
+type ResourceCondition func(obj *unstructured.Unstructured) (bool, error)
+
+// NestedInt64AtLeast returns a ResourceCondition which holds when the integer field at the given path
+// is set and is greater than or equal to minValue, e.g. NestedInt64AtLeast(1, "status", "availableReplicas").
+func NestedInt64AtLeast(minValue int64, fields ...string) ResourceCondition {
+	return func(obj *unstructured.Unstructured) (bool, error) {
+		value, found, err := unstructured.NestedInt64(obj.Object, fields...)
+		if err != nil || !found {
+			return false, err
+		}
+		return value >= minValue, nil
+	}
+}
+
+// WaitTimeoutError is returned by WaitForResource when the condition does not hold within the given timeout.
+type WaitTimeoutError struct {
+	GVK     schema.GroupVersionKind
+	Key     client.ObjectKey
+	Timeout time.Duration
+}
+
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %s %s", e.Timeout, e.GVK.Kind, e.Key)
+}
+
+// WaitForResource polls the resource identified by 'gvk' and 'key' every 'interval' until 'condition' holds.
+// A resource which does not exist yet is treated as not ready. If the condition does not hold within 'timeout'
+// a *WaitTimeoutError is returned.
+func WaitForResource(ctx context.Context, cli client.Client, gvk schema.GroupVersionKind, key client.ObjectKey,
+	condition ResourceCondition, interval, timeout time.Duration) error {
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		if err := cli.Get(ctx, key, obj); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+
+		return condition(obj)
+	})
+	if err != nil && wait.Interrupted(err) && ctx.Err() == nil {
+		return &WaitTimeoutError{GVK: gvk, Key: key, Timeout: timeout}
+	}
+
+	return err
+}
+
 func CreateWithRetry(ctx context.Context, cli client.Client, obj client.Object, timeoutMin int) error {
 	interval := time.Second * 5 // arbitrary value
 	timeout := time.Duration(timeoutMin) * time.Minute
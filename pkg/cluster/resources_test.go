@@ -0,0 +1,84 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestWaitForResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(appsv1.AddToScheme(scheme))
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kueue-controller-manager",
+			Namespace: "opendatahub",
+		},
+	}
+	key := client.ObjectKeyFromObject(deployment)
+
+	// the deployment becomes available after it has been observed a couple of times
+	gets := 0
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(deployment).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				gets++
+				if gets == 3 {
+					available := &appsv1.Deployment{}
+					if err := c.Get(ctx, key, available); err != nil {
+						return err
+					}
+					available.Status.AvailableReplicas = 1
+					if err := c.Status().Update(ctx, available); err != nil {
+						return err
+					}
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	err := cluster.WaitForResource(ctx, cli, gvk.Deployment, key,
+		cluster.NestedInt64AtLeast(1, "status", "availableReplicas"), 10*time.Millisecond, time.Second)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(gets).To(BeNumerically(">=", 3))
+}
+
+func TestWaitForResourceTimeout(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(appsv1.AddToScheme(scheme))
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+	key := client.ObjectKey{Name: "missing", Namespace: "opendatahub"}
+
+	err := cluster.WaitForResource(ctx, cli, gvk.Deployment, key,
+		cluster.NestedInt64AtLeast(1, "status", "availableReplicas"), 10*time.Millisecond, 50*time.Millisecond)
+
+	var timeoutErr *cluster.WaitTimeoutError
+	g.Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+	g.Expect(timeoutErr.Key).To(Equal(key))
+	g.Expect(timeoutErr.GVK).To(Equal(gvk.Deployment))
+}
@@ -34,10 +34,16 @@ func StrToUnstructured(resources string) ([]*unstructured.Unstructured, error) {
 }
 
 // ResourceToUnstructured converts a resource.Resource to an Unstructured object.
+// The resource is serialized straight to JSON, skipping the YAML round trip which is
+// expensive for components rendering many resources.
 func ResourceToUnstructured(res *resource.Resource) (*unstructured.Unstructured, error) {
-	u := &unstructured.Unstructured{}
+	data, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
 
-	if err := yaml.Unmarshal([]byte(res.MustYaml()), u); err != nil {
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(data); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,115 @@
+package conversion_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conversion"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestResourceToUnstructured(t *testing.T) {
+	g := NewWithT(t)
+
+	res, err := provider.NewDefaultDepProvider().GetResourceFactory().FromBytes([]byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: component
+  namespace: opendatahub
+spec:
+  replicas: 3
+`))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	u, err := conversion.ResourceToUnstructured(res)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(u.GetKind()).To(Equal("Deployment"))
+	g.Expect(u.GetName()).To(Equal("component"))
+	g.Expect(u.GetNamespace()).To(Equal("opendatahub"))
+
+	// numbers must be int64 to be usable by the unstructured helpers and DeepCopy
+	replicas, found, err := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(replicas).To(Equal(int64(3)))
+	g.Expect(u.DeepCopy()).To(Equal(u))
+}
+
+// BenchmarkRenderLarge measures the render pipeline of DeployManifestsFromPath, from the kustomize build to the
+// conversion of the resources to apply, over a synthetic set of 500 resources.
+func BenchmarkRenderLarge(b *testing.B) {
+	var manifests strings.Builder
+	for i := 0; i < 250; i++ {
+		fmt.Fprintf(&manifests, `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment-%[1]d
+  labels:
+    app: component
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: component-%[1]d
+  template:
+    metadata:
+      labels:
+        app: component-%[1]d
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+        ports:
+        - containerPort: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: service-%[1]d
+spec:
+  selector:
+    app: component-%[1]d
+  ports:
+  - port: 80
+`, i)
+	}
+
+	fs := filesys.MakeFsInMemory()
+	if err := fs.WriteFile("/manifests/resources.yaml", []byte(manifests.String())); err != nil {
+		b.Fatal(err)
+	}
+	if err := fs.WriteFile("/manifests/kustomization.yaml", []byte("resources:\n- resources.yaml\n")); err != nil {
+		b.Fatal(err)
+	}
+	k := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resMap, err := k.Run(fs, "/manifests")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := plugins.CreateNamespaceApplierPlugin("opendatahub").Transform(resMap); err != nil {
+			b.Fatal(err)
+		}
+		if err := plugins.CreateAddLabelsPlugin("component").Transform(resMap); err != nil {
+			b.Fatal(err)
+		}
+		for _, res := range resMap.Resources() {
+			if _, err := conversion.ResourceToUnstructured(res); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"archive/tar"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -28,6 +30,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"golang.org/x/exp/maps"
@@ -39,6 +42,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/kustomize/api/konfig"
 	"sigs.k8s.io/kustomize/api/krusty"
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/resource"
@@ -58,25 +63,16 @@ var (
 // DownloadManifests function performs following tasks:
 // 1. It takes component URI and only downloads folder specified by component.ContextDir field
 // 2. It saves the manifests in the odh-manifests/component-name/ folder.
+// Besides http(s) URIs, a "file://" URI reads a local tar.gz manifests bundle, for offline installations.
 func DownloadManifests(ctx context.Context, componentName string, manifestConfig components.ManifestsConfig) error {
-	// Get the component repo from the given url
-	// e.g.  https://github.com/example/tarball/master
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestConfig.URI, nil)
+	bundle, err := openManifestsBundle(ctx, manifestConfig.URI)
 	if err != nil {
 		return err
 	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("error downloading manifests: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("error downloading manifests: %v HTTP status", resp.StatusCode)
-	}
+	defer bundle.Close()
 
 	// Create a new gzip reader
-	gzipReader, err := gzip.NewReader(resp.Body)
+	gzipReader, err := gzip.NewReader(bundle)
 	if err != nil {
 		return fmt.Errorf("error creating gzip reader: %w", err)
 	}
@@ -91,6 +87,7 @@ func DownloadManifests(ctx context.Context, componentName string, manifestConfig
 	if err != nil {
 		return fmt.Errorf("error creating manifests directory : %w", err)
 	}
+	componentPath := filepath.Join(DefaultManifestPath, componentName)
 
 	// Extract the contents of the TAR archive to the current directory
 	for {
@@ -112,8 +109,15 @@ func DownloadManifests(ctx context.Context, componentName string, manifestConfig
 			componentFoldersList := strings.Split(componentFileName, "/")
 			componentFileRelativePathFound := strings.Join(componentFoldersList[len(strings.Split(componentManifestPath, "/")):], "/")
 
+			// Guard against archive entries escaping the component folder, e.g. "repo/manifests/../../etc/passwd"
+			targetPath := filepath.Join(componentPath, componentFileRelativePathFound)
+			relativePath, err := filepath.Rel(componentPath, targetPath)
+			if err != nil || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+				return fmt.Errorf("error extracting manifests: %s is outside of the manifests folder", header.Name)
+			}
+
 			if header.Typeflag == tar.TypeDir {
-				err = os.MkdirAll(DefaultManifestPath+"/"+componentName+"/"+componentFileRelativePathFound, mode)
+				err = os.MkdirAll(targetPath, mode)
 				if err != nil {
 					return fmt.Errorf("error creating directory:%w", err)
 				}
@@ -121,21 +125,8 @@ func DownloadManifests(ctx context.Context, componentName string, manifestConfig
 			}
 
 			if header.Typeflag == tar.TypeReg {
-				file, err := os.Create(DefaultManifestPath + "/" + componentName + "/" + componentFileRelativePathFound)
-				if err != nil {
-					return fmt.Errorf("error creating file: %w", err)
-				}
-
-				defer file.Close()
-
-				for {
-					_, err := io.CopyN(file, tarReader, 1024)
-					if err != nil {
-						if errors.Is(err, io.EOF) {
-							break
-						}
-						return fmt.Errorf("error downloading file contents: %w", err)
-					}
+				if err := extractFile(targetPath, tarReader); err != nil {
+					return err
 				}
 				continue
 			}
@@ -144,6 +135,75 @@ func DownloadManifests(ctx context.Context, componentName string, manifestConfig
 	return err
 }
 
+// openManifestsBundle opens the tar.gz manifests bundle at uri, either downloading it or reading a local file.
+func openManifestsBundle(ctx context.Context, uri string) (io.ReadCloser, error) {
+	if path, isFile := strings.CutPrefix(uri, "file://"); isFile {
+		bundle, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("error opening manifests: %w", err)
+		}
+		return bundle, nil
+	}
+
+	// Get the component repo from the given url
+	// e.g.  https://github.com/example/tarball/master
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading manifests: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("error downloading manifests: %v HTTP status", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+func extractFile(path string, content io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return fmt.Errorf("error creating directory:%w", err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating file: %w", err)
+	}
+	defer file.Close()
+
+	for {
+		_, err := io.CopyN(file, content, 1024)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("error downloading file contents: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// KustomizationPath returns manifestPath when it holds a kustomization file, under any of the names recognized
+// by kustomize, e.g. "kustomization.yml" or "Kustomization". Otherwise it returns the path of its "default" overlay.
+func KustomizationPath(manifestPath string) (string, error) {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		_, err := os.Stat(filepath.Join(manifestPath, name))
+		if err == nil {
+			return manifestPath, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	return filepath.Join(manifestPath, "default"), nil
+}
+
 func DeployManifestsFromPath(
 	ctx context.Context,
 	cli client.Client,
@@ -152,6 +212,7 @@ func DeployManifestsFromPath(
 	namespace string,
 	componentName string,
 	componentEnabled bool,
+	extraPlugins ...resmap.Transformer,
 ) error {
 	// Render the Kustomize manifests
 	k := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
@@ -159,12 +220,9 @@ func DeployManifestsFromPath(
 	// Create resmap
 	// Use kustomization file under manifestPath or use `default` overlay
 	var resMap resmap.ResMap
-	_, err := os.Stat(filepath.Join(manifestPath, "kustomization.yaml"))
+	manifestPath, err := KustomizationPath(manifestPath)
 	if err != nil {
-		if !os.IsNotExist(err) {
-			return err
-		}
-		manifestPath = filepath.Join(manifestPath, "default")
+		return err
 	}
 
 	resMap, err = k.Run(fs, manifestPath)
@@ -182,15 +240,52 @@ func DeployManifestsFromPath(
 		return fmt.Errorf("failed applying labels plugin when preparing Kustomize resources. %w", err)
 	}
 
-	// Create / apply / delete resources in the cluster
-	for _, res := range resMap.Resources() {
-		err = manageResource(ctx, cli, res, owner, namespace, componentName, componentEnabled)
-		if err != nil {
-			return err
+	// Component specific plugins run last, on resources already carrying the namespace and common labels
+	for _, plugin := range extraPlugins {
+		if err := plugin.Transform(resMap); err != nil {
+			return fmt.Errorf("failed applying plugin when preparing Kustomize resources. %w", err)
 		}
 	}
 
-	return nil
+	// Create / apply resources in the cluster CRDs first so that the resources using them can be created, and
+	// delete them in the reverse order so that the resources are deleted before their CRDs
+	crds, rest := SplitCRDs(resMap.Resources())
+	resources := append(crds, rest...)
+	if componentEnabled {
+		for _, res := range resources {
+			err = manageResource(ctx, cli, res, owner, namespace, componentName, componentEnabled)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// The deletions do not depend on each other, a failing one does not leave the others behind
+	deleteErrors := &MultiError{}
+	slices.Reverse(resources)
+	for _, res := range resources {
+		if err := manageResource(ctx, cli, res, owner, namespace, componentName, componentEnabled); err != nil {
+			deleteErrors.Add(fmt.Errorf("failed removing %s %s/%s: %w", res.GetKind(), res.GetNamespace(), res.GetName(), err))
+		}
+	}
+
+	return deleteErrors.ErrorOrNil()
+}
+
+// SplitCRDs separates the CustomResourceDefinitions from the other resources, keeping the order of both.
+func SplitCRDs(resources []*resource.Resource) ([]*resource.Resource, []*resource.Resource) {
+	var crds, rest []*resource.Resource
+	for _, res := range resources {
+		if res.GetGvk().Group == "apiextensions.k8s.io" && res.GetKind() == "CustomResourceDefinition" {
+			crds = append(crds, res)
+			continue
+		}
+		rest = append(rest, res)
+	}
+
+	return crds, rest
 }
 
 func manageResource(ctx context.Context, cli client.Client, res *resource.Resource, owner metav1.Object, applicationNamespace, componentName string, enabled bool) error {
@@ -199,6 +294,10 @@ func manageResource(ctx context.Context, cli client.Client, res *resource.Resour
 		return nil
 	}
 
+	// The reconcile ID is the one of the reconcile logs, and of the resources rendered with CreateReconcileIDPlugin
+	logger := ctrl.Log.WithValues("reconcileID", controller.ReconcileIDFromContext(ctx), "component", componentName,
+		"kind", res.GetKind(), "namespace", res.GetNamespace(), "name", res.GetName())
+
 	found, err := getResource(ctx, cli, res)
 
 	if err == nil {
@@ -208,11 +307,14 @@ func manageResource(ctx context.Context, cli client.Client, res *resource.Resour
 			// do not reconcile kserve resource with annotation "opendatahub.io/managed: false"
 			// TODO: remove this exception when we define managed annotation across odh
 			if found.GetAnnotations()[annotations.ManagedByODHOperator] == "false" && componentName == "kserve" {
+				SkippedResourcesTotal.WithLabelValues(componentName, SkippedReasonUnmanaged).Inc()
 				return nil
 			}
+			logger.V(1).Info("applying resource")
 			return updateResource(ctx, cli, res, found, owner)
 		}
 		// Delete resource if it exists or do nothing if not found
+		logger.V(1).Info("removing resource of disabled component")
 		return handleDisabledComponent(ctx, cli, found, componentName)
 	}
 
@@ -222,6 +324,7 @@ func manageResource(ctx context.Context, cli client.Client, res *resource.Resour
 
 	// Create resource when component enabled
 	if enabled {
+		logger.V(1).Info("creating resource")
 		return createResource(ctx, cli, res, owner)
 	}
 	// Skip if resource doesn't exist and component is disabled
@@ -277,6 +380,9 @@ func createResource(ctx context.Context, cli client.Client, res *resource.Resour
 	if err != nil {
 		return err
 	}
+	if err := keepUnchangedReconcileID(obj, nil); err != nil {
+		return err
+	}
 	if obj.GetKind() != "CustomResourceDefinition" && obj.GetKind() != "OdhDashboardConfig" {
 		if err := ctrl.SetControllerReference(owner, metav1.Object(obj), cli.Scheme()); err != nil {
 			return err
@@ -307,9 +413,47 @@ func updateResource(ctx context.Context, cli client.Client, res *resource.Resour
 	// Retain existing labels on update
 	updateLabels(found, obj)
 
+	if err := keepUnchangedReconcileID(obj, found); err != nil {
+		return err
+	}
+
 	return performPatch(ctx, cli, obj, found, owner)
 }
 
+// keepUnchangedReconcileID stamps the resources carrying a reconcile ID with a checksum of their rendered content,
+// and keeps the reconcile ID of the live object when that checksum did not change. Otherwise every reconcile would
+// write every resource only to renew the identifier, firing watch events for nothing.
+func keepUnchangedReconcileID(obj, found *unstructured.Unstructured) error {
+	objAnnotations := obj.GetAnnotations()
+	id, ok := objAnnotations[annotations.ReconcileID]
+	if !ok {
+		return nil
+	}
+
+	delete(objAnnotations, annotations.ReconcileID)
+	delete(objAnnotations, annotations.RenderedChecksum)
+	obj.SetAnnotations(objAnnotations)
+	content, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	if found != nil {
+		foundAnnotations := found.GetAnnotations()
+		if foundID := foundAnnotations[annotations.ReconcileID]; foundID != "" && foundAnnotations[annotations.RenderedChecksum] == checksum {
+			id = foundID
+		}
+	}
+
+	objAnnotations[annotations.ReconcileID] = id
+	objAnnotations[annotations.RenderedChecksum] = checksum
+	obj.SetAnnotations(objAnnotations)
+
+	return nil
+}
+
 // skipUpdateOnAllowlistedFields applies RemoverPlugin to the component's resources
 // This ensures that we do not overwrite the fields when Patch is applied later to the resource.
 func skipUpdateOnAllowlistedFields(res *resource.Resource) error {
@@ -0,0 +1,449 @@
+package deploy_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestKustomizationPath(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, name := range []string{"kustomization.yaml", "kustomization.yml", "Kustomization"} {
+		manifestPath := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(manifestPath, name), []byte("resources: []\n"), 0o600)).To(Succeed())
+
+		path, err := deploy.KustomizationPath(manifestPath)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(path).To(Equal(manifestPath), name)
+	}
+}
+
+func TestKustomizationPathDefaultOverlay(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := t.TempDir()
+
+	path, err := deploy.KustomizationPath(manifestPath)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(path).To(Equal(filepath.Join(manifestPath, "default")))
+}
+
+func TestDeployManifestsFromPathCountsUnmanagedResources(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(manifestPath, "kustomization.yaml"), []byte("resources:\n- configmap.yaml\n"), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(manifestPath, "configmap.yaml"), []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: inferenceservice-config
+data:
+  key: rendered
+`), 0o600)).To(Succeed())
+
+	cli := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "inferenceservice-config",
+			Namespace:   "opendatahub",
+			Annotations: map[string]string{annotations.ManagedByODHOperator: "false"},
+		},
+		Data: map[string]string{"key": "user-defined"},
+	}).Build()
+	owner := &metav1.ObjectMeta{Name: "default-dsc"}
+
+	before := testutil.ToFloat64(deploy.SkippedResourcesTotal.WithLabelValues("kserve", deploy.SkippedReasonUnmanaged))
+	g.Expect(deploy.DeployManifestsFromPath(context.Background(), cli, owner, manifestPath, "opendatahub", "kserve", true)).To(Succeed())
+
+	g.Expect(testutil.ToFloat64(deploy.SkippedResourcesTotal.WithLabelValues("kserve", deploy.SkippedReasonUnmanaged))).To(Equal(before + 1))
+
+	configMap := &corev1.ConfigMap{}
+	g.Expect(cli.Get(context.Background(), client.ObjectKey{Name: "inferenceservice-config", Namespace: "opendatahub"}, configMap)).To(Succeed())
+	g.Expect(configMap.Data).To(HaveKeyWithValue("key", "user-defined"))
+}
+
+func TestSkipMissingGroupsPluginCountsSkippedResources(t *testing.T) {
+	g := NewWithT(t)
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes([]byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kueue-manager-config
+---
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: kueue-metrics
+`))
+	g.Expect(err).NotTo(HaveOccurred())
+	discoveryCli := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{
+		Resources: []*metav1.APIResourceList{{GroupVersion: "v1"}},
+	}}
+
+	before := testutil.ToFloat64(deploy.SkippedResourcesTotal.WithLabelValues("kueue", deploy.SkippedReasonMissingGroup))
+	g.Expect(deploy.CreateSkipMissingGroupsPlugin(discoveryCli, "kueue").Transform(resMap)).To(Succeed())
+
+	g.Expect(testutil.ToFloat64(deploy.SkippedResourcesTotal.WithLabelValues("kueue", deploy.SkippedReasonMissingGroup))).To(Equal(before + 1))
+	g.Expect(resMap.Resources()).To(HaveLen(1))
+}
+
+func TestDeployManifestsFromPathKeepsReconcileIDOfUnchangedResources(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(manifestPath, "kustomization.yaml"), []byte("resources:\n- configmap.yaml\n"), 0o600)).To(Succeed())
+	writeConfigMap := func(value string) {
+		g.Expect(os.WriteFile(filepath.Join(manifestPath, "configmap.yaml"), []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kueue-manager-config
+data:
+  key: `+value+`
+`), 0o600)).To(Succeed())
+	}
+
+	var applied *unstructured.Unstructured
+	cli := fake.NewClientBuilder().WithScheme(newScheme(g)).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+			data, err := patch.Data(obj)
+			if err != nil {
+				return err
+			}
+			applied = &unstructured.Unstructured{}
+			return applied.UnmarshalJSON(data)
+		},
+	}).Build()
+	owner := &dscv1.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc", UID: "uid"}}
+
+	deployConfigMap := func() string {
+		reconcileIDPlugin := plugins.CreateReconcileIDPlugin(context.Background())
+		g.Expect(deploy.DeployManifestsFromPath(context.Background(), cli, owner, manifestPath, "opendatahub", "kueue", true, reconcileIDPlugin)).
+			To(Succeed())
+
+		return reconcileIDPlugin.Annotations[annotations.ReconcileID]
+	}
+
+	writeConfigMap("initial")
+	createdID := deployConfigMap()
+	created := &corev1.ConfigMap{}
+	g.Expect(cli.Get(context.Background(), client.ObjectKey{Name: "kueue-manager-config", Namespace: "opendatahub"}, created)).To(Succeed())
+	g.Expect(created.Annotations).To(HaveKeyWithValue(annotations.ReconcileID, createdID))
+	g.Expect(created.Annotations).To(HaveKey(annotations.RenderedChecksum))
+
+	// nothing changed, the live identifier is applied back
+	g.Expect(deployConfigMap()).NotTo(Equal(createdID))
+	g.Expect(applied.GetAnnotations()).To(Equal(created.Annotations))
+
+	writeConfigMap("changed")
+	changedID := deployConfigMap()
+	g.Expect(applied.GetAnnotations()).To(HaveKeyWithValue(annotations.ReconcileID, changedID))
+	g.Expect(applied.GetAnnotations()[annotations.RenderedChecksum]).NotTo(Equal(created.Annotations[annotations.RenderedChecksum]))
+}
+
+func newScheme(g *WithT) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(dscv1.AddToScheme(scheme)).To(Succeed())
+
+	return scheme
+}
+
+// writeManifestsBundle writes the given files, keyed by their path in the archive, into a tar.gz bundle.
+func writeManifestsBundle(t *testing.T, files map[string]string) string {
+	t.Helper()
+	g := NewWithT(t)
+
+	bundlePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	bundle, err := os.Create(bundlePath)
+	g.Expect(err).NotTo(HaveOccurred())
+	defer bundle.Close()
+
+	gzipWriter := gzip.NewWriter(bundle)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		g.Expect(tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Typeflag: tar.TypeReg,
+			Mode:     0o600,
+			Size:     int64(len(content)),
+		})).To(Succeed())
+		_, err := tarWriter.Write([]byte(content))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	g.Expect(tarWriter.Close()).To(Succeed())
+	g.Expect(gzipWriter.Close()).To(Succeed())
+
+	return bundlePath
+}
+
+func useManifestPath(t *testing.T) string {
+	t.Helper()
+
+	defaultManifestPath := deploy.DefaultManifestPath
+	deploy.DefaultManifestPath = t.TempDir()
+	t.Cleanup(func() { deploy.DefaultManifestPath = defaultManifestPath })
+
+	return deploy.DefaultManifestPath
+}
+
+func TestDownloadManifestsFromBundle(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := useManifestPath(t)
+	bundlePath := writeManifestsBundle(t, map[string]string{
+		"repo/manifests/base/kustomization.yaml": "resources:\n- configmap.yaml\n",
+		"repo/manifests/base/configmap.yaml":     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: component-config\n",
+		"repo/README.md":                         "not a manifest",
+	})
+
+	g.Expect(deploy.DownloadManifests(context.Background(), "component", components.ManifestsConfig{
+		URI:        "file://" + bundlePath,
+		ContextDir: "manifests",
+	})).To(Succeed())
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(filesys.MakeFsOnDisk(),
+		filepath.Join(manifestPath, "component", "base"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resMap.Resources()).To(HaveLen(1))
+	g.Expect(resMap.Resources()[0].GetName()).To(Equal("component-config"))
+
+	g.Expect(filepath.Join(manifestPath, "component", "README.md")).NotTo(BeAnExistingFile())
+}
+
+func TestDownloadManifestsRejectsPathTraversal(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := useManifestPath(t)
+	bundlePath := writeManifestsBundle(t, map[string]string{
+		"repo/manifests/../../../escaped.yaml": "kind: ConfigMap\n",
+	})
+
+	g.Expect(deploy.DownloadManifests(context.Background(), "component", components.ManifestsConfig{
+		URI:        "file://" + bundlePath,
+		ContextDir: "manifests",
+	})).To(MatchError(ContainSubstring("outside of the manifests folder")))
+
+	g.Expect(filepath.Join(filepath.Dir(manifestPath), "escaped.yaml")).NotTo(BeAnExistingFile())
+}
+
+func TestDownloadManifestsAcceptsDotDotPrefixedNames(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := useManifestPath(t)
+	bundlePath := writeManifestsBundle(t, map[string]string{
+		"repo/manifests/base/..data":     "kept\n",
+		"repo/manifests/base/..foo.yaml": "kind: ConfigMap\n",
+	})
+
+	g.Expect(deploy.DownloadManifests(context.Background(), "component", components.ManifestsConfig{
+		URI:        "file://" + bundlePath,
+		ContextDir: "manifests",
+	})).To(Succeed())
+
+	g.Expect(filepath.Join(manifestPath, "component", "base", "..data")).To(BeAnExistingFile())
+	g.Expect(filepath.Join(manifestPath, "component", "base", "..foo.yaml")).To(BeAnExistingFile())
+}
+
+func TestSplitCRDs(t *testing.T) {
+	g := NewWithT(t)
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes([]byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: kueue-controller-manager
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: clusterqueues.kueue.x-k8s.io
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kueue-manager-config
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: localqueues.kueue.x-k8s.io
+`))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	names := func(resources []*resource.Resource) []string {
+		result := make([]string, 0, len(resources))
+		for _, res := range resources {
+			result = append(result, res.GetName())
+		}
+		return result
+	}
+
+	crds, rest := deploy.SplitCRDs(resMap.Resources())
+	g.Expect(names(crds)).To(Equal([]string{"clusterqueues.kueue.x-k8s.io", "localqueues.kueue.x-k8s.io"}))
+	g.Expect(names(rest)).To(Equal([]string{"kueue-controller-manager", "kueue-manager-config"}))
+}
+
+func TestDeployManifestsFromPathOrdersCRDs(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(manifestPath, "kustomization.yaml"), []byte("resources:\n- resources.yaml\n"), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(manifestPath, "resources.yaml"), []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kueue-manager-config
+---
+apiVersion: kueue.x-k8s.io/v1beta1
+kind: LocalQueue
+metadata:
+  name: default
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: localqueues.kueue.x-k8s.io
+`), 0o600)).To(Succeed())
+	owner := &dscv1.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc", UID: "uid"}}
+
+	// deployResources returns the kinds of the resources DeployManifestsFromPath gets, then creates or deletes
+	deployResources := func(enabled bool) ([]string, []string) {
+		var gets, changes []string
+		cli := fake.NewClientBuilder().WithScheme(newScheme(g)).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				gvk := obj.GetObjectKind().GroupVersionKind()
+				gets = append(gets, gvk.Kind)
+				if enabled {
+					return k8serr.NewNotFound(schema.GroupResource{Group: gvk.Group}, key.Name)
+				}
+				obj.SetName(key.Name)
+				obj.SetNamespace(key.Namespace)
+				obj.SetLabels(map[string]string{labels.ODH.Component("kueue"): "true"})
+				return nil
+			},
+			Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+				changes = append(changes, obj.GetObjectKind().GroupVersionKind().Kind)
+				return nil
+			},
+			Delete: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.DeleteOption) error {
+				changes = append(changes, obj.GetObjectKind().GroupVersionKind().Kind)
+				return nil
+			},
+		}).Build()
+
+		g.Expect(deploy.DeployManifestsFromPath(context.Background(), cli, owner, manifestPath, "opendatahub", "kueue", enabled)).To(Succeed())
+
+		return gets, changes
+	}
+
+	gets, created := deployResources(true)
+	g.Expect(gets).To(Equal([]string{"CustomResourceDefinition", "ConfigMap", "LocalQueue"}))
+	g.Expect(created).To(Equal([]string{"CustomResourceDefinition", "ConfigMap", "LocalQueue"}))
+
+	// the CRDs of disabled components are kept
+	gets, deleted := deployResources(false)
+	g.Expect(gets).To(Equal([]string{"LocalQueue", "ConfigMap", "CustomResourceDefinition"}))
+	g.Expect(deleted).To(Equal([]string{"LocalQueue", "ConfigMap"}))
+}
+
+func TestMultiError(t *testing.T) {
+	g := NewWithT(t)
+
+	errFirst := errors.New("first")
+	errSecond := errors.New("second")
+
+	multiErr := &deploy.MultiError{}
+	for _, err := range []error{nil, errFirst, nil, fmt.Errorf("wrapped: %w", errSecond)} {
+		multiErr.Add(err)
+	}
+
+	err := multiErr.ErrorOrNil()
+	g.Expect(err).To(MatchError("2 resources failed: first; wrapped: second"))
+	g.Expect(errors.Is(err, errFirst)).To(BeTrue())
+	g.Expect(errors.Is(err, errSecond)).To(BeTrue())
+	g.Expect((&deploy.MultiError{}).ErrorOrNil()).To(Succeed())
+}
+
+func TestDeployManifestsFromPathRemovesAllResourcesOfDisabledComponents(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestPath := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(manifestPath, "kustomization.yaml"), []byte("resources:\n- configmaps.yaml\n"), 0o600)).To(Succeed())
+	var manifests strings.Builder
+	for _, name := range []string{"first", "second", "third", "fourth"} {
+		fmt.Fprintf(&manifests, "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: %s\n", name)
+	}
+	g.Expect(os.WriteFile(filepath.Join(manifestPath, "configmaps.yaml"), []byte(manifests.String()), 0o600)).To(Succeed())
+
+	var objects []client.Object
+	for _, name := range []string{"first", "second", "third", "fourth"} {
+		objects = append(objects, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "opendatahub",
+			Labels:    map[string]string{labels.ODH.Component("kueue"): "true"},
+		}})
+	}
+	errDelete := errors.New("delete failed")
+	cli := fake.NewClientBuilder().WithObjects(objects...).WithInterceptorFuncs(interceptor.Funcs{
+		Delete: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			if obj.GetName() == "second" || obj.GetName() == "fourth" {
+				return errDelete
+			}
+			return cli.Delete(ctx, obj, opts...)
+		},
+	}).Build()
+	owner := &dscv1.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc", UID: "uid"}}
+
+	err := deploy.DeployManifestsFromPath(context.Background(), cli, owner, manifestPath, "opendatahub", "kueue", false)
+
+	multiErr := &deploy.MultiError{}
+	g.Expect(errors.As(err, &multiErr)).To(BeTrue())
+	g.Expect(multiErr.Errors).To(HaveLen(2))
+	g.Expect(errors.Is(err, errDelete)).To(BeTrue())
+	g.Expect(err.Error()).To(And(ContainSubstring("ConfigMap opendatahub/second"), ContainSubstring("ConfigMap opendatahub/fourth")))
+
+	configMaps := &corev1.ConfigMapList{}
+	g.Expect(cli.List(context.Background(), configMaps)).To(Succeed())
+	names := make([]string, 0, len(configMaps.Items))
+	for _, configMap := range configMaps.Items {
+		names = append(names, configMap.Name)
+	}
+	g.Expect(names).To(ConsistOf("second", "fourth"))
+}
@@ -0,0 +1,42 @@
+package deploy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultiError aggregates the errors of the resources of a multi-resource operation which carries on past the
+// failing resources, so that the caller can report all of them.
+type MultiError struct {
+	Errors []error
+}
+
+// Add appends err to the aggregate, nil errors are ignored.
+func (e *MultiError) Add(err error) {
+	if err != nil {
+		e.Errors = append(e.Errors, err)
+	}
+}
+
+// ErrorOrNil returns the aggregate, or nil when no error was added.
+func (e *MultiError) ErrorOrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e *MultiError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+
+	return fmt.Sprintf("%d resources failed: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the aggregated errors, for errors.Is and errors.As to match any of them.
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
@@ -0,0 +1,43 @@
+package deploy
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/kustomize/api/resource"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+)
+
+// Reasons of the resources counted by SkippedResourcesTotal.
+const (
+	// SkippedReasonUnmanaged is the reason of resources left as is because they are annotated as not managed.
+	SkippedReasonUnmanaged = "unmanaged"
+	// SkippedReasonMissingGroup is the reason of resources dropped because the cluster does not serve their API group.
+	SkippedReasonMissingGroup = "missing-group"
+)
+
+// SkippedResourcesTotal counts the rendered resources the operator intentionally did not reconcile,
+// by component and reason.
+var SkippedResourcesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "opendatahub_deploy_skipped_resources_total",
+		Help: "Number of rendered resources not reconciled by the operator, by component and reason.",
+	},
+	[]string{"component", "reason"},
+)
+
+func init() { //nolint:gochecknoinits
+	metrics.Registry.MustRegister(SkippedResourcesTotal)
+}
+
+// CreateSkipMissingGroupsPlugin creates a plugin dropping the resources of the API groups the cluster does not
+// serve, counting them in SkippedResourcesTotal for the given component.
+func CreateSkipMissingGroupsPlugin(cli discovery.DiscoveryInterface, componentName string) *plugins.SkipMissingGroupsPlugin {
+	plugin := plugins.CreateSkipMissingGroupsPlugin(cli)
+	plugin.OnSkip = func(*resource.Resource) {
+		SkippedResourcesTotal.WithLabelValues(componentName, SkippedReasonMissingGroup).Inc()
+	}
+
+	return plugin
+}
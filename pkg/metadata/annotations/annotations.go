@@ -13,3 +13,26 @@ const (
 	SecretLengthAnnotation      = "secret-generator.opendatahub.io/complexity"
 	SecretOauthClientAnnotation = "secret-generator.opendatahub.io/oauth-client-route"
 )
+
+// TargetNamespace routes a rendered resource to a namespace other than the applications namespace,
+// i.e. TargetNamespaceMonitoring deploys it into the DSCI monitoring namespace.
+const (
+	TargetNamespace           = "platform.opendatahub.io/target-namespace"
+	TargetNamespaceMonitoring = "monitoring"
+)
+
+// ReconcileID holds the identifier of the reconcile which last rendered a resource, as logged by the controller.
+const ReconcileID = "platform.opendatahub.io/reconcile-id"
+
+// RenderedChecksum holds a checksum of the rendered content of a resource stamped with a ReconcileID, so that the
+// identifier is only renewed when the content changes.
+const RenderedChecksum = "platform.opendatahub.io/rendered-checksum"
+
+// ConfigChecksum holds a checksum of the ConfigMaps and Secrets a pod template uses, so that changing them rolls out the pods.
+const ConfigChecksum = "platform.opendatahub.io/config-checksum"
+
+// Argo CD options of the resources, for the clusters where Argo CD syncs resources alongside the operator.
+const (
+	ArgoCDCompareOptions = "argocd.argoproj.io/compare-options"
+	ArgoCDSyncOptions    = "argocd.argoproj.io/sync-options"
+)
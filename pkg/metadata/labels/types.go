@@ -4,6 +4,8 @@ const (
 	ODHAppPrefix      = "app.opendatahub.io"
 	InjectTrustCA     = "config.openshift.io/inject-trusted-cabundle"
 	SecurityEnforce   = "pod-security.kubernetes.io/enforce"
+	SecurityAudit     = "pod-security.kubernetes.io/audit"
+	SecurityWarn      = "pod-security.kubernetes.io/warn"
 	ClusterMonitoring = "openshift.io/cluster-monitoring"
 )
 
@@ -11,9 +13,17 @@ const (
 // used across the project.
 // [1] (https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/#labels)
 var K8SCommon = struct {
-	PartOf string
+	Name      string
+	Instance  string
+	Version   string
+	PartOf    string
+	ManagedBy string
 }{
-	PartOf: "app.kubernetes.io/part-of",
+	Name:      "app.kubernetes.io/name",
+	Instance:  "app.kubernetes.io/instance",
+	Version:   "app.kubernetes.io/version",
+	PartOf:    "app.kubernetes.io/part-of",
+	ManagedBy: "app.kubernetes.io/managed-by",
 }
 
 // ODH holds Open Data Hub specific labels grouped by types.
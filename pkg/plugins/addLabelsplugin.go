@@ -1,7 +1,9 @@
 package plugins
 
 import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/kustomize/api/builtins" //nolint:staticcheck // Remove after package update
+	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/types"
 	"sigs.k8s.io/kustomize/kyaml/resid"
 
@@ -40,3 +42,105 @@ func CreateAddLabelsPlugin(componentName string) *builtins.LabelTransformerPlugi
 		},
 	}
 }
+
+// CreateAddLabelsForKindsPlugin creates a label transformer plugin that adds the given label
+// to the "metadata/labels" path of resources matching one of the given kinds only.
+func CreateAddLabelsForKindsPlugin(key, value string, gvks ...schema.GroupVersionKind) *builtins.LabelTransformerPlugin {
+	fieldSpecs := make([]types.FieldSpec, 0, len(gvks))
+	for _, gvk := range gvks {
+		fieldSpecs = append(fieldSpecs, types.FieldSpec{
+			Gvk:                resid.Gvk{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+			Path:               "metadata/labels",
+			CreateIfNotPresent: true,
+		})
+	}
+
+	return &builtins.LabelTransformerPlugin{
+		Labels: map[string]string{
+			key: value,
+		},
+		FieldSpecs: fieldSpecs,
+	}
+}
+
+// RecommendedLabelsPlugin adds the Kubernetes recommended "app.kubernetes.io" labels to the "metadata/labels"
+// path of all resources. Selectors are left untouched, as the version changes across upgrades and Deployment
+// selectors are immutable.
+type RecommendedLabelsPlugin struct {
+	Labels map[string]string
+	// ManagedBy is the "app.kubernetes.io/managed-by" label of the resources which do not set one.
+	ManagedBy string
+}
+
+var _ resmap.Transformer = &RecommendedLabelsPlugin{}
+
+// CreateRecommendedLabelsPlugin creates a plugin adding the recommended labels with the given values, empty ones
+// are not set. The managed-by value, usually the operator of the platform as returned by cluster.OperatorName,
+// only applies to the resources whose manifests do not set one.
+func CreateRecommendedLabelsPlugin(name, instance, version, partOf, managedBy string) *RecommendedLabelsPlugin {
+	recommendedLabels := map[string]string{}
+	for key, value := range map[string]string{
+		labels.K8SCommon.Name:     name,
+		labels.K8SCommon.Instance: instance,
+		labels.K8SCommon.Version:  version,
+		labels.K8SCommon.PartOf:   partOf,
+	} {
+		if value != "" {
+			recommendedLabels[key] = value
+		}
+	}
+
+	return &RecommendedLabelsPlugin{
+		Labels:    recommendedLabels,
+		ManagedBy: managedBy,
+	}
+}
+
+// Transform adds the labels to the resources of the ResMap.
+func (p *RecommendedLabelsPlugin) Transform(m resmap.ResMap) error {
+	labelsPlugin := &builtins.LabelTransformerPlugin{
+		Labels: p.Labels,
+		FieldSpecs: []types.FieldSpec{
+			{
+				Gvk:                resid.Gvk{},
+				Path:               "metadata/labels",
+				CreateIfNotPresent: true,
+			},
+		},
+	}
+	if err := labelsPlugin.Transform(m); err != nil {
+		return err
+	}
+
+	if p.ManagedBy == "" {
+		return nil
+	}
+	for _, res := range m.Resources() {
+		resLabels := res.GetLabels()
+		if _, ok := resLabels[labels.K8SCommon.ManagedBy]; ok {
+			continue
+		}
+		resLabels[labels.K8SCommon.ManagedBy] = p.ManagedBy
+		if err := res.SetLabels(resLabels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateAggregationLabelsPlugin creates a label transformer plugin adding the given labels to the
+// "metadata/labels" path of ClusterRoles only, e.g. "rbac.authorization.k8s.io/aggregate-to-edit", so that
+// their rules are aggregated into the ClusterRoles selecting these labels.
+func CreateAggregationLabelsPlugin(aggregationLabels map[string]string) *builtins.LabelTransformerPlugin {
+	return &builtins.LabelTransformerPlugin{
+		Labels: aggregationLabels,
+		FieldSpecs: []types.FieldSpec{
+			{
+				Gvk:                resid.Gvk{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+				Path:               "metadata/labels",
+				CreateIfNotPresent: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package plugins_test
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add labels for kinds plugin", func() {
+	var resMap resmap.ResMap
+
+	BeforeEach(func() {
+		var err error
+		resMap, err = resMapFactory.NewResMapFromBytes([]byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: component-config
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment-a
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment-b
+  labels:
+    app: b
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment-c
+`))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should add the label to the matching kinds only", func() {
+		labelsPlugin := plugins.CreateAddLabelsForKindsPlugin("app.kubernetes.io/component", "workload", gvk.Deployment)
+		Expect(labelsPlugin.Transform(resMap)).To(Succeed())
+
+		for _, res := range resMap.Resources() {
+			if res.GetKind() == gvk.Deployment.Kind {
+				Expect(res.GetLabels()).To(HaveKeyWithValue("app.kubernetes.io/component", "workload"))
+			} else {
+				Expect(res.GetLabels()).NotTo(HaveKey("app.kubernetes.io/component"))
+			}
+		}
+	})
+})
+
+var _ = Describe("Recommended labels plugin", func() {
+	It("Should add the recommended labels to all resources without changing selectors", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		labelsPlugin := plugins.CreateRecommendedLabelsPlugin("kueue", "default-dsc", "2.13.0", "kueue", "rhods-operator")
+		Expect(labelsPlugin.Transform(resMap)).To(Succeed())
+
+		for _, res := range resMap.Resources() {
+			Expect(res.GetLabels()).To(Equal(map[string]string{
+				"app.kubernetes.io/name":       "kueue",
+				"app.kubernetes.io/instance":   "default-dsc",
+				"app.kubernetes.io/version":    "2.13.0",
+				"app.kubernetes.io/part-of":    "kueue",
+				"app.kubernetes.io/managed-by": "rhods-operator",
+			}))
+		}
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.selector.matchLabels")).
+			To(Equal(map[string]interface{}{"app": "managed"}))
+	})
+
+	It("Should keep the managed-by label set by the manifests", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kueue-manager-config
+  labels:
+    app.kubernetes.io/managed-by: kustomize
+`)
+
+		Expect(plugins.CreateRecommendedLabelsPlugin("kueue", "", "", "", "rhods-operator").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetLabels()).To(HaveKeyWithValue("app.kubernetes.io/managed-by", "rhods-operator"))
+		Expect(getResource(resMap, gvk.ConfigMap, "kueue-manager-config").GetLabels()).To(HaveKeyWithValue("app.kubernetes.io/managed-by", "kustomize"))
+	})
+})
+
+var _ = Describe("Aggregation labels plugin", func() {
+	It("Should add the aggregation labels to ClusterRoles only", func() {
+		resMap := newResMap(`
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: kueue-batch-user-role
+`, `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: kueue-leader-election-role
+`)
+
+		aggregationLabels := map[string]string{"rbac.authorization.k8s.io/aggregate-to-edit": "true"}
+		Expect(plugins.CreateAggregationLabelsPlugin(aggregationLabels).Transform(resMap)).To(Succeed())
+
+		for _, res := range resMap.Resources() {
+			if res.GetKind() == "ClusterRole" {
+				Expect(res.GetLabels()).To(Equal(aggregationLabels))
+			} else {
+				Expect(res.GetLabels()).To(BeEmpty())
+			}
+		}
+	})
+})
@@ -0,0 +1,35 @@
+package plugins
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+// APIVersionMigrationPlugin rewrites deprecated API versions of kinds, e.g. CronJobs from "batch/v1beta1" to
+// "batch/v1", so that older manifests can be applied on clusters which stopped serving them.
+// Only the apiVersion is changed: it handles renames where the schema of the kind stayed the same, and
+// kinds whose schema changed, e.g. Ingresses from "extensions/v1beta1", must not be migrated with it.
+type APIVersionMigrationPlugin struct {
+	// Versions maps the deprecated kinds to the group versions to migrate them to.
+	Versions map[schema.GroupVersionKind]schema.GroupVersion
+}
+
+var _ resmap.Transformer = &APIVersionMigrationPlugin{}
+
+// CreateAPIVersionMigrationPlugin creates a plugin migrating the given kinds.
+func CreateAPIVersionMigrationPlugin(versions map[schema.GroupVersionKind]schema.GroupVersion) *APIVersionMigrationPlugin {
+	return &APIVersionMigrationPlugin{
+		Versions: versions,
+	}
+}
+
+// Transform sets the migrated apiVersion on the resources of the ResMap of a deprecated kind.
+func (p *APIVersionMigrationPlugin) Transform(m resmap.ResMap) error {
+	for _, res := range m.Resources() {
+		if to, ok := p.Versions[resourceGvk(res.GetGvk().Group, res.GetGvk().Version, res.GetKind())]; ok {
+			res.SetApiVersion(to.String())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package plugins_test
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API version migration plugin", func() {
+	It("Should rewrite the deprecated API versions of the given kinds only", func() {
+		resMap := newResMap(componentConfigMap, `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: legacy
+`, `
+apiVersion: batch/v1beta1
+kind: Job
+metadata:
+  name: same-version-other-kind
+`)
+
+		Expect(plugins.CreateAPIVersionMigrationPlugin(map[schema.GroupVersionKind]schema.GroupVersion{
+			{Group: "batch", Version: "v1beta1", Kind: "CronJob"}: gvk.CronJob.GroupVersion(),
+		}).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.CronJob, "legacy").GetApiVersion()).To(Equal("batch/v1"))
+		Expect(getResource(resMap, schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "Job"}, "same-version-other-kind").
+			GetApiVersion()).To(Equal("batch/v1beta1"))
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").GetApiVersion()).To(Equal("v1"))
+	})
+})
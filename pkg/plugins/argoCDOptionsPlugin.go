@@ -0,0 +1,37 @@
+package plugins
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/builtins" //nolint:staticcheck // Remove after package update
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// CreateArgoCDOptionsPlugin creates an annotations transformer plugin setting the Argo CD compare and sync
+// options on the resources matching one of the given kinds only, e.g. "IgnoreExtraneous" and "Prune=false",
+// so that Argo CD does not report or revert the fields managed by the operator. Empty options are not set.
+func CreateArgoCDOptionsPlugin(compareOptions, syncOptions string, gvks ...schema.GroupVersionKind) *builtins.AnnotationsTransformerPlugin {
+	argoCDAnnotations := map[string]string{}
+	if compareOptions != "" {
+		argoCDAnnotations[annotations.ArgoCDCompareOptions] = compareOptions
+	}
+	if syncOptions != "" {
+		argoCDAnnotations[annotations.ArgoCDSyncOptions] = syncOptions
+	}
+
+	fieldSpecs := make([]types.FieldSpec, 0, len(gvks))
+	for _, gvk := range gvks {
+		fieldSpecs = append(fieldSpecs, types.FieldSpec{
+			Gvk:                resid.Gvk{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+			Path:               "metadata/annotations",
+			CreateIfNotPresent: true,
+		})
+	}
+
+	return &builtins.AnnotationsTransformerPlugin{
+		Annotations: argoCDAnnotations,
+		FieldSpecs:  fieldSpecs,
+	}
+}
@@ -0,0 +1,33 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Argo CD options plugin", func() {
+	It("Should set the Argo CD options on the given kinds only", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		Expect(plugins.CreateArgoCDOptionsPlugin("IgnoreExtraneous", "Prune=false", gvk.Deployment).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetAnnotations()).To(Equal(map[string]string{
+			"argocd.argoproj.io/compare-options": "IgnoreExtraneous",
+			"argocd.argoproj.io/sync-options":    "Prune=false",
+		}))
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").GetAnnotations()).To(BeEmpty())
+	})
+
+	It("Should not set empty options", func() {
+		resMap := newResMap(managedDeployment)
+
+		Expect(plugins.CreateArgoCDOptionsPlugin("", "Prune=false", gvk.Deployment).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetAnnotations()).To(Equal(map[string]string{
+			"argocd.argoproj.io/sync-options": "Prune=false",
+		}))
+	})
+})
@@ -0,0 +1,152 @@
+package plugins
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// ConfigChecksumPlugin annotates the pod template of workloads with a checksum of the ConfigMaps and Secrets
+// they reference through volumes, including projected ones, envFrom or env, when those are rendered along with them. Changing the
+// content of one of them changes the annotation and so rolls out the pods on apply.
+type ConfigChecksumPlugin struct{}
+
+var _ resmap.Transformer = &ConfigChecksumPlugin{}
+
+// CreateConfigChecksumPlugin creates a plugin stamping the pod templates with the checksum of their configuration.
+func CreateConfigChecksumPlugin() *ConfigChecksumPlugin {
+	return &ConfigChecksumPlugin{}
+}
+
+// Transform sets annotations.ConfigChecksum on the pod template of the workloads of the ResMap referencing
+// at least one rendered ConfigMap or Secret.
+func (p *ConfigChecksumPlugin) Transform(m resmap.ResMap) error {
+	for _, res := range m.Resources() {
+		resGvk := resourceGvk(res.GetGvk().Group, res.GetGvk().Version, res.GetGvk().Kind)
+		path, isWorkload := podTemplatePaths[resGvk]
+		if !isWorkload {
+			continue
+		}
+
+		template, err := res.Pipe(kyaml.Lookup(path...))
+		if err != nil {
+			return fmt.Errorf("failed looking up pod template of %s: %w", res.CurId(), err)
+		}
+		if template == nil {
+			continue
+		}
+
+		content, err := template.String()
+		if err != nil {
+			return fmt.Errorf("failed serializing pod template of %s: %w", res.CurId(), err)
+		}
+		podTemplate := corev1.PodTemplateSpec{}
+		if err := yaml.Unmarshal([]byte(content), &podTemplate); err != nil {
+			return fmt.Errorf("failed parsing pod template of %s: %w", res.CurId(), err)
+		}
+
+		checksum, err := configChecksum(m, res.GetNamespace(), podTemplate.Spec)
+		if err != nil {
+			return fmt.Errorf("failed computing configuration checksum of %s: %w", res.CurId(), err)
+		}
+		if checksum == "" {
+			continue
+		}
+
+		if err := template.PipeE(
+			kyaml.LookupCreate(kyaml.MappingNode, "metadata", "annotations"),
+			kyaml.SetField(annotations.ConfigChecksum, kyaml.NewStringRNode(checksum)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configChecksum returns the checksum of the content of the rendered ConfigMaps and Secrets the pod uses,
+// or an empty string when it uses none of them.
+func configChecksum(m resmap.ResMap, namespace string, spec corev1.PodSpec) (string, error) {
+	refs := map[string]bool{}
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			refs["ConfigMap/"+volume.ConfigMap.Name] = true
+		}
+		if volume.Secret != nil {
+			refs["Secret/"+volume.Secret.SecretName] = true
+		}
+		if volume.Projected == nil {
+			continue
+		}
+		for _, source := range volume.Projected.Sources {
+			if source.ConfigMap != nil {
+				refs["ConfigMap/"+source.ConfigMap.Name] = true
+			}
+			if source.Secret != nil {
+				refs["Secret/"+source.Secret.Name] = true
+			}
+		}
+	}
+	for _, container := range slices.Concat(spec.InitContainers, spec.Containers) {
+		for _, source := range container.EnvFrom {
+			if source.ConfigMapRef != nil {
+				refs["ConfigMap/"+source.ConfigMapRef.Name] = true
+			}
+			if source.SecretRef != nil {
+				refs["Secret/"+source.SecretRef.Name] = true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil {
+				refs["ConfigMap/"+env.ValueFrom.ConfigMapKeyRef.Name] = true
+			}
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				refs["Secret/"+env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+	}
+
+	var configs []*resource.Resource
+	for _, res := range m.Resources() {
+		if res.GetNamespace() == namespace && refs[res.GetKind()+"/"+res.GetName()] {
+			configs = append(configs, res)
+		}
+	}
+	if len(configs) == 0 {
+		return "", nil
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].GetKind()+"/"+configs[i].GetName() < configs[j].GetKind()+"/"+configs[j].GetName()
+	})
+
+	hash := sha256.New()
+	for _, config := range configs {
+		hash.Write([]byte(config.GetKind() + "/" + config.GetName() + "\n"))
+		for _, field := range []string{"data", "binaryData", "stringData"} {
+			content, err := config.Pipe(kyaml.Lookup(field))
+			if err != nil {
+				return "", err
+			}
+			if content == nil {
+				continue
+			}
+			serialized, err := content.String()
+			if err != nil {
+				return "", err
+			}
+			hash.Write([]byte(field + "\n" + serialized))
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,118 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const configMountingDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: component
+spec:
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+      volumes:
+      - name: config
+        configMap:
+          name: component-config
+`
+
+var _ = Describe("Config checksum plugin", func() {
+	podChecksum := func(configMap string) string {
+		resMap := newResMap(configMountingDeployment, managedDeployment, configMap)
+		Expect(plugins.CreateConfigChecksumPlugin().Transform(resMap)).To(Succeed())
+
+		// workloads not referencing any rendered configuration are not annotated
+		Expect(getResource(resMap, gvk.Deployment, "managed").MustYaml()).To(MatchYAML(managedDeployment))
+
+		checksum, err := getResource(resMap, gvk.Deployment, "component").GetString(
+			"spec.template.metadata.annotations." + escapeDots(annotations.ConfigChecksum))
+		Expect(err).NotTo(HaveOccurred())
+
+		return checksum
+	}
+
+	It("Should change the pod template checksum when the ConfigMap changes", func() {
+		checksum := podChecksum(componentConfigMap)
+		Expect(checksum).NotTo(BeEmpty())
+		Expect(podChecksum(componentConfigMap)).To(Equal(checksum))
+
+		Expect(podChecksum(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: component-config
+data:
+  key: changed
+`)).NotTo(Equal(checksum))
+	})
+
+	It("Should include the ConfigMaps and Secrets of projected volumes", func() {
+		projectedChecksum := func(configMap, secret string) string {
+			resMap := newResMap(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: component
+spec:
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+      volumes:
+      - name: config
+        projected:
+          sources:
+          - configMap:
+              name: component-config
+          - secret:
+              name: component-secret
+`, configMap, secret)
+			Expect(plugins.CreateConfigChecksumPlugin().Transform(resMap)).To(Succeed())
+
+			checksum, err := getResource(resMap, gvk.Deployment, "component").GetString(
+				"spec.template.metadata.annotations." + escapeDots(annotations.ConfigChecksum))
+			Expect(err).NotTo(HaveOccurred())
+
+			return checksum
+		}
+		secret := func(value string) string {
+			return "apiVersion: v1\nkind: Secret\nmetadata:\n  name: component-secret\nstringData:\n  key: " + value + "\n"
+		}
+
+		checksum := projectedChecksum(componentConfigMap, secret("initial"))
+		Expect(checksum).NotTo(BeEmpty())
+		Expect(projectedChecksum(componentConfigMap, secret("changed"))).NotTo(Equal(checksum))
+		Expect(projectedChecksum(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: component-config
+data:
+  key: changed
+`, secret("initial"))).NotTo(Equal(checksum))
+	})
+
+	It("Should annotate the workloads following a workload without pod template", func() {
+		resMap := newResMap(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: template-less
+`, configMountingDeployment, componentConfigMap)
+		Expect(plugins.CreateConfigChecksumPlugin().Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "component").GetString(
+			"spec.template.metadata.annotations." + escapeDots(annotations.ConfigChecksum))).NotTo(BeEmpty())
+	})
+})
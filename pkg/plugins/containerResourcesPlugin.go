@@ -0,0 +1,65 @@
+package plugins
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/types"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// ContainerResourcesPlugin sets resource requirements on the workload containers which do not define any,
+// e.g. the defaults recommended by a component when its manifests omit them.
+type ContainerResourcesPlugin struct {
+	Resources corev1.ResourceRequirements
+	// Target selects the workloads to set the requirements on, when nil all the workloads are.
+	Target *types.Selector
+}
+
+var _ resmap.Transformer = &ContainerResourcesPlugin{}
+
+// CreateContainerResourcesPlugin creates a plugin setting the given resource requirements where unset.
+func CreateContainerResourcesPlugin(resources corev1.ResourceRequirements) *ContainerResourcesPlugin {
+	return &ContainerResourcesPlugin{
+		Resources: resources,
+	}
+}
+
+// CreateContainerResourcesForTargetPlugin creates a plugin setting the given resource requirements where unset,
+// on the containers of the selected workloads only, e.g. the controller manager of a component.
+func CreateContainerResourcesForTargetPlugin(resources corev1.ResourceRequirements, target *types.Selector) *ContainerResourcesPlugin {
+	return &ContainerResourcesPlugin{
+		Resources: resources,
+		Target:    target,
+	}
+}
+
+// Transform sets "resources" on the containers of the workloads of the ResMap. Empty requirements are a no-op.
+func (p *ContainerResourcesPlugin) Transform(m resmap.ResMap) error {
+	if len(p.Resources.Requests) == 0 && len(p.Resources.Limits) == 0 {
+		return nil
+	}
+
+	resources, err := toRNode(p.Resources)
+	if err != nil {
+		return err
+	}
+
+	if p.Target != nil {
+		selected, err := m.Select(*p.Target)
+		if err != nil {
+			return err
+		}
+
+		// the selected resources are shared with m, so transforming them transforms m
+		m = resmap.New()
+		for _, res := range selected {
+			if err := m.Append(res); err != nil {
+				return err
+			}
+		}
+	}
+
+	return transformContainers(m, nil, func(container *kyaml.RNode) error {
+		return setFieldIfUnset(container, resources.Copy(), "resources")
+	})
+}
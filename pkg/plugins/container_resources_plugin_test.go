@@ -0,0 +1,83 @@
+package plugins_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Container resources plugin", func() {
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+	}
+
+	It("Should set the resources on the containers which do not define any", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: author-defined
+spec:
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+        resources:
+          requests:
+            cpu: 1
+`)
+
+		Expect(plugins.CreateContainerResourcesPlugin(resources).Transform(resMap)).To(Succeed())
+
+		managed := getResource(resMap, gvk.Deployment, "managed")
+		Expect(managed.GetString("spec.template.spec.containers[0].resources.requests.cpu")).To(Equal("100m"))
+		Expect(managed.GetString("spec.template.spec.containers[0].resources.requests.memory")).To(Equal("128Mi"))
+
+		authorDefined := getResource(resMap, gvk.Deployment, "author-defined")
+		Expect(authorDefined.GetFieldValue("spec.template.spec.containers[0].resources")).To(Equal(map[string]interface{}{
+			"requests": map[string]interface{}{"cpu": 1},
+		}))
+	})
+
+	It("Should leave the resources untouched when no requirement is given", func() {
+		resMap := newResMap(managedDeployment)
+
+		Expect(plugins.CreateContainerResourcesPlugin(corev1.ResourceRequirements{}).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").MustYaml()).To(MatchYAML(managedDeployment))
+	})
+
+	It("Should set the resources on the containers of the selected workloads only", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: unrelated
+spec:
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+`)
+
+		target := &types.Selector{ResId: resid.NewResId(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "managed")}
+		Expect(plugins.CreateContainerResourcesForTargetPlugin(resources, target).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetString("spec.template.spec.containers[0].resources.requests.cpu")).
+			To(Equal("100m"))
+		Expect(getResource(resMap, gvk.Deployment, "unrelated").GetFieldValue("spec.template.spec.containers[0].resources")).
+			Error().To(HaveOccurred())
+	})
+})
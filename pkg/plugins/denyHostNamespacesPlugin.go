@@ -0,0 +1,67 @@
+package plugins
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// hostNamespaceFields are the pod spec fields sharing a namespace of the node with the pod.
+var hostNamespaceFields = []string{"hostNetwork", "hostPID", "hostIPC"}
+
+// DenyHostNamespacesPlugin fails the rendering when a workload requests one of the host namespaces.
+// Managed clusters do not admit pods sharing the network, process or IPC namespace of the node.
+type DenyHostNamespacesPlugin struct {
+	Enabled bool
+}
+
+var _ resmap.Transformer = &DenyHostNamespacesPlugin{}
+
+// CreateDenyHostNamespacesPlugin creates a plugin denying host namespaces on the managed platform only.
+func CreateDenyHostNamespacesPlugin(platform cluster.Platform) *DenyHostNamespacesPlugin {
+	return &DenyHostNamespacesPlugin{
+		Enabled: platform == cluster.ManagedRhoai,
+	}
+}
+
+// Transform returns an error listing the workloads of the ResMap requesting host namespaces.
+// Resources are not modified.
+func (p *DenyHostNamespacesPlugin) Transform(m resmap.ResMap) error {
+	if !p.Enabled {
+		return nil
+	}
+
+	var denied []string
+	for _, res := range m.Resources() {
+		resGvk := resourceGvk(res.GetGvk().Group, res.GetGvk().Version, res.GetGvk().Kind)
+		path, isWorkload := podTemplatePaths[resGvk]
+		if !isWorkload {
+			continue
+		}
+
+		spec, err := res.Pipe(kyaml.Lookup(slices.Concat(path, []string{"spec"})...))
+		if err != nil {
+			return fmt.Errorf("failed looking up pod spec of %s: %w", res.CurId(), err)
+		}
+		if spec == nil {
+			continue
+		}
+
+		for _, field := range hostNamespaceFields {
+			if value := spec.Field(field); value != nil && kyaml.GetValue(value.Value) == "true" {
+				denied = append(denied, fmt.Sprintf("%s/%s: %s", res.GetKind(), res.GetName(), field))
+			}
+		}
+	}
+
+	if len(denied) > 0 {
+		return fmt.Errorf("workloads must not use host namespaces, found %s", strings.Join(denied, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const hostNetworkDaemonSet = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: node-agent
+spec:
+  template:
+    spec:
+      hostNetwork: true
+      hostPID: false
+      containers:
+      - name: agent
+        image: agent:latest
+`
+
+var _ = Describe("Deny host namespaces plugin", func() {
+	It("Should reject workloads using host namespaces in managed mode", func() {
+		resMap := newResMap(managedDeployment, hostNetworkDaemonSet)
+
+		err := plugins.CreateDenyHostNamespacesPlugin(cluster.ManagedRhoai).Transform(resMap)
+		Expect(err).To(MatchError(ContainSubstring("DaemonSet/node-agent: hostNetwork")))
+		Expect(err).NotTo(MatchError(ContainSubstring("hostPID")))
+		Expect(err).NotTo(MatchError(ContainSubstring("Deployment/managed")))
+	})
+
+	It("Should accept workloads using host namespaces on other platforms", func() {
+		resMap := newResMap(managedDeployment, hostNetworkDaemonSet)
+
+		Expect(plugins.CreateDenyHostNamespacesPlugin(cluster.OpenDataHub).Transform(resMap)).To(Succeed())
+	})
+})
@@ -0,0 +1,70 @@
+package plugins
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// EnvFromPlugin adds an "envFrom" source, a ConfigMap or a Secret, to the containers of workloads, e.g. to
+// inject a shared configuration into every pod of a component. Containers already using a source referencing
+// the same ConfigMap or Secret are left as is.
+type EnvFromPlugin struct {
+	Source corev1.EnvFromSource
+	// Gvks restricts the workload kinds the source is added to, all workloads when empty.
+	Gvks []schema.GroupVersionKind
+}
+
+var _ resmap.Transformer = &EnvFromPlugin{}
+
+// CreateEnvFromPlugin creates a plugin adding the given source to the containers of the workloads of the given kinds.
+func CreateEnvFromPlugin(source corev1.EnvFromSource, gvks ...schema.GroupVersionKind) *EnvFromPlugin {
+	return &EnvFromPlugin{
+		Source: source,
+		Gvks:   gvks,
+	}
+}
+
+// Transform adds the source to the "envFrom" of the containers of the workloads of the ResMap.
+func (p *EnvFromPlugin) Transform(m resmap.ResMap) error {
+	source, err := toRNode(p.Source)
+	if err != nil {
+		return err
+	}
+	refField, refName := envFromRef(p.Source)
+
+	return transformContainers(m, p.Gvks, func(container *kyaml.RNode) error {
+		sources, err := container.Pipe(kyaml.LookupCreate(kyaml.SequenceNode, "envFrom"))
+		if err != nil {
+			return err
+		}
+
+		elements, err := sources.Elements()
+		if err != nil {
+			return err
+		}
+		for _, elem := range elements {
+			name, err := elem.Pipe(kyaml.Lookup(refField, "name"))
+			if err != nil {
+				return err
+			}
+			if name != nil && kyaml.GetValue(name) == refName {
+				return nil
+			}
+		}
+
+		return sources.PipeE(kyaml.Append(source.Copy().YNode()))
+	})
+}
+
+func envFromRef(source corev1.EnvFromSource) (string, string) {
+	if source.SecretRef != nil {
+		return "secretRef", source.SecretRef.Name
+	}
+	if source.ConfigMapRef != nil {
+		return "configMapRef", source.ConfigMapRef.Name
+	}
+
+	return "", ""
+}
@@ -0,0 +1,46 @@
+package plugins_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EnvFrom plugin", func() {
+	It("Should add the ConfigMap source to the workload containers once", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		envFromPlugin := plugins.CreateEnvFromPlugin(corev1.EnvFromSource{
+			ConfigMapRef: &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "component-config"},
+			},
+		}, gvk.Deployment)
+		Expect(envFromPlugin.Transform(resMap)).To(Succeed())
+		Expect(envFromPlugin.Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.template.spec.containers[0].envFrom")).
+			To(Equal([]interface{}{
+				map[string]interface{}{
+					"configMapRef": map[string]interface{}{"name": "component-config"},
+				},
+			}))
+	})
+
+	It("Should add a Secret source next to a ConfigMap source with the same name", func() {
+		resMap := newResMap(managedDeployment)
+
+		for _, source := range []corev1.EnvFromSource{
+			{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "shared"}}},
+			{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "shared"}}},
+		} {
+			Expect(plugins.CreateEnvFromPlugin(source).Transform(resMap)).To(Succeed())
+		}
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.template.spec.containers[0].envFrom")).
+			To(HaveLen(2))
+	})
+})
@@ -0,0 +1,42 @@
+package plugins
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// FinalizerPlugin adds a finalizer to the resources of the given kinds which do not carry it yet, e.g. to
+// control the deletion order of a namespace or a PersistentVolumeClaim.
+type FinalizerPlugin struct {
+	Finalizer string
+	Gvks      []schema.GroupVersionKind
+}
+
+var _ resmap.Transformer = &FinalizerPlugin{}
+
+// CreateFinalizerPlugin creates a plugin adding the given finalizer to the resources of the given kinds.
+func CreateFinalizerPlugin(finalizer string, gvks ...schema.GroupVersionKind) *FinalizerPlugin {
+	return &FinalizerPlugin{
+		Finalizer: finalizer,
+		Gvks:      gvks,
+	}
+}
+
+// Transform adds the finalizer to the matching resources of the ResMap.
+func (p *FinalizerPlugin) Transform(m resmap.ResMap) error {
+	return transformKinds(m, p.Gvks, func(node *kyaml.RNode) error {
+		finalizers, err := node.Pipe(kyaml.LookupCreate(kyaml.SequenceNode, "metadata", "finalizers"))
+		if err != nil {
+			return err
+		}
+
+		for _, value := range finalizers.YNode().Content {
+			if value.Value == p.Finalizer {
+				return nil
+			}
+		}
+
+		return finalizers.PipeE(kyaml.Append(kyaml.NewStringRNode(p.Finalizer).YNode()))
+	})
+}
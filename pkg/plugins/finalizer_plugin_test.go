@@ -0,0 +1,36 @@
+package plugins_test
+
+import (
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const componentNamespace = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: component-ns
+  finalizers:
+  - kubernetes
+`
+
+var _ = Describe("Finalizer plugin", func() {
+	It("Should add the finalizer to the resources of the given kinds only", func() {
+		resMap := newResMap(componentNamespace, componentConfigMap)
+
+		plugin := plugins.CreateFinalizerPlugin("opendatahub.io/cleanup", gvk.Namespace)
+		Expect(plugin.Transform(resMap)).To(Succeed())
+		Expect(plugin.Transform(resMap)).To(Succeed())
+
+		namespace := resMap.GetMatchingResourcesByAnyId(resid.NewResId(resid.Gvk{Version: "v1", Kind: gvk.Namespace.Kind}, "component-ns").GvknEquals)
+		Expect(namespace).To(HaveLen(1))
+		Expect(namespace[0].GetSlice("metadata.finalizers")).To(
+			Equal([]interface{}{"kubernetes", "opendatahub.io/cleanup"}))
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").GetSlice("metadata.finalizers")).Error().To(HaveOccurred())
+	})
+})
@@ -0,0 +1,79 @@
+package plugins_test
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	. "github.com/onsi/gomega"
+)
+
+var resMapFactory = resmap.NewFactory(factory)
+
+// managedDeployment is a workload without any of the settings the workload plugins add.
+const managedDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: managed
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: managed
+  template:
+    metadata:
+      labels:
+        app: managed
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+        ports:
+        - containerPort: 80
+`
+
+// componentConfigMap is a resource which is not a workload.
+const componentConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: component-config
+data:
+  key: value
+`
+
+func newResMap(docs ...string) resmap.ResMap {
+	resMap, err := resMapFactory.NewResMapFromBytes([]byte(strings.Join(docs, "\n---\n")))
+	Expect(err).NotTo(HaveOccurred())
+
+	return resMap
+}
+
+func getResource(resMap resmap.ResMap, gvk schema.GroupVersionKind, name string) *resource.Resource {
+	res, err := resMap.GetById(resid.NewResId(resid.Gvk{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind}, name))
+	Expect(err).NotTo(HaveOccurred())
+
+	return res
+}
+
+// escapeDots escapes the dots of a label or annotation key to look it up with resource.GetString.
+func escapeDots(key string) string {
+	return strings.ReplaceAll(key, ".", "\\.")
+}
+
+// discoveryFor returns a discovery client of a cluster serving the given group versions.
+func discoveryFor(groupVersions ...string) *fakediscovery.FakeDiscovery {
+	resources := make([]*metav1.APIResourceList, 0, len(groupVersions))
+	for _, groupVersion := range groupVersions {
+		resources = append(resources, &metav1.APIResourceList{GroupVersion: groupVersion})
+	}
+
+	return &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: resources}}
+}
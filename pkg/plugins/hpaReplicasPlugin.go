@@ -0,0 +1,37 @@
+package plugins
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// HPAReplicasPlugin removes "spec/replicas" from the Deployments scaled by a HorizontalPodAutoscaler, so that
+// applying the manifests does not reset the replicas set by the autoscaler.
+type HPAReplicasPlugin struct {
+	Targets []string
+}
+
+var _ resmap.Transformer = &HPAReplicasPlugin{}
+
+// CreateHPAReplicasPlugin creates a plugin removing the replicas of the Deployments with the given names.
+func CreateHPAReplicasPlugin(targets ...string) *HPAReplicasPlugin {
+	return &HPAReplicasPlugin{
+		Targets: targets,
+	}
+}
+
+// Transform removes the replicas of the targeted Deployments of the ResMap.
+func (p *HPAReplicasPlugin) Transform(m resmap.ResMap) error {
+	return transformKinds(m, []schema.GroupVersionKind{gvk.Deployment}, func(node *kyaml.RNode) error {
+		if !slices.Contains(p.Targets, node.GetName()) {
+			return nil
+		}
+
+		return node.PipeE(kyaml.Lookup("spec"), kyaml.FieldClearer{Name: "replicas"})
+	})
+}
@@ -0,0 +1,27 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HPA replicas plugin", func() {
+	It("Should remove the replicas of the Deployments scaled by an autoscaler only", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fixed
+spec:
+  replicas: 2
+`)
+
+		Expect(plugins.CreateHPAReplicasPlugin("managed").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.replicas")).Error().To(HaveOccurred())
+		Expect(getResource(resMap, gvk.Deployment, "fixed").GetFieldValue("spec.replicas")).To(Equal(2))
+	})
+})
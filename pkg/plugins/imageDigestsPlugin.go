@@ -0,0 +1,75 @@
+package plugins
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// RequireImageDigestsPlugin fails the rendering when a workload container image is referenced by tag
+// instead of digest. Managed clusters only pull images pinned by digest.
+type RequireImageDigestsPlugin struct {
+	Enabled bool
+}
+
+var _ resmap.Transformer = &RequireImageDigestsPlugin{}
+
+// CreateRequireImageDigestsPlugin creates a plugin requiring image digests on the managed platform only.
+func CreateRequireImageDigestsPlugin(platform cluster.Platform) *RequireImageDigestsPlugin {
+	return &RequireImageDigestsPlugin{
+		Enabled: platform == cluster.ManagedRhoai,
+	}
+}
+
+// Transform returns an error listing the container images of the ResMap which are not pinned by digest.
+// Resources are not modified.
+func (p *RequireImageDigestsPlugin) Transform(m resmap.ResMap) error {
+	if !p.Enabled {
+		return nil
+	}
+
+	var unpinned []string
+	for _, res := range m.Resources() {
+		resGvk := resourceGvk(res.GetGvk().Group, res.GetGvk().Version, res.GetGvk().Kind)
+		path, isWorkload := podTemplatePaths[resGvk]
+		if !isWorkload {
+			continue
+		}
+
+		for _, field := range []string{"initContainers", "containers"} {
+			containers, err := res.Pipe(kyaml.Lookup(slices.Concat(path, []string{"spec", field})...))
+			if err != nil {
+				return fmt.Errorf("failed looking up %s of %s: %w", field, res.CurId(), err)
+			}
+			if containers == nil {
+				continue
+			}
+
+			err = containers.VisitElements(func(container *kyaml.RNode) error {
+				image, err := container.GetString("image")
+				if err != nil {
+					return err
+				}
+				if !strings.Contains(image, "@sha256:") {
+					unpinned = append(unpinned, fmt.Sprintf("%s/%s: %s", res.GetKind(), res.GetName(), image))
+				}
+
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed reading images of %s: %w", res.CurId(), err)
+			}
+		}
+	}
+
+	if len(unpinned) > 0 {
+		return fmt.Errorf("images must be referenced by digest, found tagged images %s", strings.Join(unpinned, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package plugins
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// ImagePullPolicyPlugin sets the image pull policy of the workload containers, init containers included.
+// By default only containers without a policy are updated, Override forces the policy on all of them.
+type ImagePullPolicyPlugin struct {
+	Policy   corev1.PullPolicy
+	Override bool
+}
+
+var _ resmap.Transformer = &ImagePullPolicyPlugin{}
+
+// CreateImagePullPolicyPlugin creates a plugin setting the given policy where unset.
+func CreateImagePullPolicyPlugin(policy corev1.PullPolicy) (*ImagePullPolicyPlugin, error) {
+	return newImagePullPolicyPlugin(policy, false)
+}
+
+// CreateImagePullPolicyOverridePlugin creates a plugin setting the given policy on all containers.
+func CreateImagePullPolicyOverridePlugin(policy corev1.PullPolicy) (*ImagePullPolicyPlugin, error) {
+	return newImagePullPolicyPlugin(policy, true)
+}
+
+func newImagePullPolicyPlugin(policy corev1.PullPolicy, override bool) (*ImagePullPolicyPlugin, error) {
+	switch policy {
+	case corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever:
+	default:
+		return nil, fmt.Errorf("invalid image pull policy %q, must be one of %s, %s or %s",
+			policy, corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever)
+	}
+
+	return &ImagePullPolicyPlugin{
+		Policy:   policy,
+		Override: override,
+	}, nil
+}
+
+// Transform sets "imagePullPolicy" on the containers of the workloads of the ResMap.
+func (p *ImagePullPolicyPlugin) Transform(m resmap.ResMap) error {
+	return transformPodTemplates(m, nil, func(template *kyaml.RNode) error {
+		for _, field := range []string{"initContainers", "containers"} {
+			containers, err := template.Pipe(kyaml.Lookup("spec", field))
+			if err != nil {
+				return err
+			}
+			if containers == nil {
+				continue
+			}
+
+			if err := containers.VisitElements(p.setPolicy); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p *ImagePullPolicyPlugin) setPolicy(container *kyaml.RNode) error {
+	policy := kyaml.NewStringRNode(string(p.Policy))
+	if p.Override {
+		return container.PipeE(kyaml.SetField("imagePullPolicy", policy))
+	}
+
+	return setFieldIfUnset(container, policy, "imagePullPolicy")
+}
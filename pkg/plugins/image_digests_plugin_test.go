@@ -0,0 +1,44 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const pinnedDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: pinned
+spec:
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx@sha256:f7988fb6c02e0ce69257d9bd9cf37ae20a60f1df7563c3a2a6abe24160306b8d
+`
+
+var _ = Describe("Require image digests plugin", func() {
+	It("Should reject tagged images in managed mode", func() {
+		resMap := newResMap(managedDeployment, pinnedDeployment, componentConfigMap)
+
+		err := plugins.CreateRequireImageDigestsPlugin(cluster.ManagedRhoai).Transform(resMap)
+		Expect(err).To(MatchError(ContainSubstring("Deployment/managed: nginx:1.14.2")))
+		Expect(err).NotTo(MatchError(ContainSubstring("Deployment/pinned")))
+	})
+
+	It("Should accept images pinned by digest in managed mode", func() {
+		resMap := newResMap(pinnedDeployment, componentConfigMap)
+
+		Expect(plugins.CreateRequireImageDigestsPlugin(cluster.ManagedRhoai).Transform(resMap)).To(Succeed())
+	})
+
+	It("Should accept tagged images in self-managed mode", func() {
+		resMap := newResMap(managedDeployment)
+
+		Expect(plugins.CreateRequireImageDigestsPlugin(cluster.SelfManagedRhoai).Transform(resMap)).To(Succeed())
+	})
+})
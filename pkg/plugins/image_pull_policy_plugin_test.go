@@ -0,0 +1,62 @@
+package plugins_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const pinnedPolicyDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: pinned
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: busybox
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+        imagePullPolicy: Always
+`
+
+var _ = Describe("Image pull policy plugin", func() {
+	It("Should set the policy on the containers where unset", func() {
+		resMap := newResMap(managedDeployment, pinnedPolicyDeployment)
+
+		policyPlugin, err := plugins.CreateImagePullPolicyPlugin(corev1.PullIfNotPresent)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyPlugin.Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetString("spec.template.spec.containers[0].imagePullPolicy")).
+			To(Equal("IfNotPresent"))
+
+		pinned := getResource(resMap, gvk.Deployment, "pinned")
+		Expect(pinned.GetString("spec.template.spec.initContainers[0].imagePullPolicy")).To(Equal("IfNotPresent"))
+		Expect(pinned.GetString("spec.template.spec.containers[0].imagePullPolicy")).To(Equal("Always"))
+	})
+
+	It("Should force the policy on all containers when overriding", func() {
+		resMap := newResMap(pinnedPolicyDeployment)
+
+		policyPlugin, err := plugins.CreateImagePullPolicyOverridePlugin(corev1.PullIfNotPresent)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyPlugin.Transform(resMap)).To(Succeed())
+
+		pinned := getResource(resMap, gvk.Deployment, "pinned")
+		Expect(pinned.GetString("spec.template.spec.initContainers[0].imagePullPolicy")).To(Equal("IfNotPresent"))
+		Expect(pinned.GetString("spec.template.spec.containers[0].imagePullPolicy")).To(Equal("IfNotPresent"))
+	})
+
+	It("Should reject an invalid policy", func() {
+		_, err := plugins.CreateImagePullPolicyPlugin("Sometimes")
+		Expect(err).To(MatchError(ContainSubstring("Sometimes")))
+	})
+})
@@ -0,0 +1,56 @@
+package plugins
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+// InheritLabelsPlugin copies an allowlist of labels from the owner of the resources, e.g. the
+// DataScienceCluster, onto the resources. It propagates labels set by users, such as a team or cost center.
+// Labels already set on a resource, by its manifest or by another plugin, are kept.
+type InheritLabelsPlugin struct {
+	Owner metav1.Object
+	Keys  []string
+}
+
+var _ resmap.Transformer = &InheritLabelsPlugin{}
+
+// CreateInheritLabelsPlugin creates a plugin copying the labels with the given keys from owner.
+func CreateInheritLabelsPlugin(owner metav1.Object, keys ...string) *InheritLabelsPlugin {
+	return &InheritLabelsPlugin{
+		Owner: owner,
+		Keys:  keys,
+	}
+}
+
+// Transform sets the allowlisted owner labels on the resources of the ResMap where unset.
+func (p *InheritLabelsPlugin) Transform(m resmap.ResMap) error {
+	ownerLabels := p.Owner.GetLabels()
+
+	for _, res := range m.Resources() {
+		resLabels := res.GetLabels()
+		changed := false
+		for _, key := range p.Keys {
+			value, ok := ownerLabels[key]
+			if !ok {
+				continue
+			}
+			if _, set := resLabels[key]; set {
+				continue
+			}
+			resLabels[key] = value
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+		if err := res.SetLabels(resLabels); err != nil {
+			return fmt.Errorf("failed setting labels of %s: %w", res.CurId(), err)
+		}
+	}
+
+	return nil
+}
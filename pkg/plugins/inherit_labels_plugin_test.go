@@ -0,0 +1,45 @@
+package plugins_test
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inherit labels plugin", func() {
+	owner := &metav1.ObjectMeta{
+		Name: "default-dsc",
+		Labels: map[string]string{
+			"team":        "ml",
+			"cost-center": "1234",
+			"internal":    "true",
+		},
+	}
+
+	It("Should copy the allowlisted owner labels to the resources", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		Expect(plugins.CreateInheritLabelsPlugin(owner, "team", "cost-center", "missing").Transform(resMap)).To(Succeed())
+
+		for _, res := range resMap.Resources() {
+			Expect(res.GetLabels()).To(Equal(map[string]string{
+				"team":        "ml",
+				"cost-center": "1234",
+			}))
+		}
+	})
+
+	It("Should keep the labels already set on the resources", func() {
+		resMap := newResMap(componentConfigMap)
+		configMap := getResource(resMap, gvk.ConfigMap, "component-config")
+		Expect(configMap.SetLabels(map[string]string{"team": "platform"})).To(Succeed())
+
+		Expect(plugins.CreateInheritLabelsPlugin(owner, "team").Transform(resMap)).To(Succeed())
+
+		Expect(configMap.GetLabels()).To(HaveKeyWithValue("team", "platform"))
+	})
+})
@@ -0,0 +1,45 @@
+package plugins
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// jobWorkloads are the kinds running pods to completion.
+var jobWorkloads = []schema.GroupVersionKind{
+	gvk.Job,
+	gvk.CronJob,
+}
+
+// JobRestartPolicyPlugin sets "restartPolicy" on the pod template of Jobs and CronJobs which do not define it.
+// Pod templates of Jobs must not use the pod default "Always".
+type JobRestartPolicyPlugin struct {
+	Policy corev1.RestartPolicy
+}
+
+var _ resmap.Transformer = &JobRestartPolicyPlugin{}
+
+// CreateJobRestartPolicyPlugin creates a plugin setting the given policy, which must be "Never" or "OnFailure".
+func CreateJobRestartPolicyPlugin(policy corev1.RestartPolicy) (*JobRestartPolicyPlugin, error) {
+	if policy != corev1.RestartPolicyNever && policy != corev1.RestartPolicyOnFailure {
+		return nil, fmt.Errorf("invalid Job restart policy %q, must be %s or %s",
+			policy, corev1.RestartPolicyNever, corev1.RestartPolicyOnFailure)
+	}
+
+	return &JobRestartPolicyPlugin{
+		Policy: policy,
+	}, nil
+}
+
+// Transform sets the restart policy on the Jobs and CronJobs of the ResMap where unset.
+func (p *JobRestartPolicyPlugin) Transform(m resmap.ResMap) error {
+	return transformPodTemplates(m, jobWorkloads, func(template *kyaml.RNode) error {
+		return setFieldIfUnset(template, kyaml.NewStringRNode(string(p.Policy)), "restartPolicy", "spec")
+	})
+}
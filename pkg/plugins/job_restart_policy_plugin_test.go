@@ -0,0 +1,53 @@
+package plugins_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Job restart policy plugin", func() {
+	It("Should set the policy on Jobs and CronJobs only", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migration
+spec:
+  template:
+    spec:
+      containers:
+      - name: migrate
+        image: migrate:latest
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: cleanup
+spec:
+  schedule: "0 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: Never
+`)
+
+		restartPolicyPlugin, err := plugins.CreateJobRestartPolicyPlugin(corev1.RestartPolicyOnFailure)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartPolicyPlugin.Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Job, "migration").GetString("spec.template.spec.restartPolicy")).To(Equal("OnFailure"))
+		Expect(getResource(resMap, gvk.CronJob, "cleanup").GetString("spec.jobTemplate.spec.template.spec.restartPolicy")).To(Equal("Never"))
+		Expect(getResource(resMap, gvk.Deployment, "managed").MustYaml()).To(MatchYAML(managedDeployment))
+	})
+
+	It("Should reject a policy Jobs do not allow", func() {
+		_, err := plugins.CreateJobRestartPolicyPlugin(corev1.RestartPolicyAlways)
+		Expect(err).To(MatchError(ContainSubstring("Always")))
+	})
+})
@@ -0,0 +1,42 @@
+package plugins
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+// TooManyResourcesError is returned when the rendering produced more resources than allowed.
+type TooManyResourcesError struct {
+	Count int
+	Max   int
+}
+
+func (e *TooManyResourcesError) Error() string {
+	return fmt.Sprintf("rendered %d resources, more than the maximum of %d", e.Count, e.Max)
+}
+
+// MaxResourcesPlugin fails the rendering when it produced more than Max resources, e.g. because of a
+// misconfigured generator, instead of flooding the API server. A Max of 0 means no limit.
+type MaxResourcesPlugin struct {
+	Max int
+}
+
+var _ resmap.Transformer = &MaxResourcesPlugin{}
+
+// CreateMaxResourcesPlugin creates a plugin allowing at most limit resources.
+func CreateMaxResourcesPlugin(limit int) *MaxResourcesPlugin {
+	return &MaxResourcesPlugin{
+		Max: limit,
+	}
+}
+
+// Transform returns a TooManyResourcesError when the ResMap holds more resources than allowed.
+// Resources are not modified.
+func (p *MaxResourcesPlugin) Transform(m resmap.ResMap) error {
+	if p.Max > 0 && m.Size() > p.Max {
+		return &TooManyResourcesError{Count: m.Size(), Max: p.Max}
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package plugins_test
+
+import (
+	"errors"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Max resources plugin", func() {
+	resources := []string{
+		managedDeployment,
+		componentConfigMap,
+		"apiVersion: v1\nkind: ServiceAccount\nmetadata:\n  name: component-sa\n",
+		"apiVersion: v1\nkind: Service\nmetadata:\n  name: component\n",
+	}
+
+	It("Should fail when more resources than allowed are rendered", func() {
+		err := plugins.CreateMaxResourcesPlugin(3).Transform(newResMap(resources...))
+
+		var tooManyResources *plugins.TooManyResourcesError
+		Expect(errors.As(err, &tooManyResources)).To(BeTrue())
+		Expect(tooManyResources.Count).To(Equal(4))
+		Expect(tooManyResources.Max).To(Equal(3))
+	})
+
+	It("Should accept any number of resources when unlimited", func() {
+		Expect(plugins.CreateMaxResourcesPlugin(0).Transform(newResMap(resources...))).To(Succeed())
+		Expect(plugins.CreateMaxResourcesPlugin(4).Transform(newResMap(resources...))).To(Succeed())
+	})
+})
@@ -0,0 +1,61 @@
+package plugins
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+// maxNameLength is the maximum length of the name of most resources, the length of a DNS subdomain.
+const maxNameLength = 253
+
+// maxNameLengths are the kinds whose name is limited further, because it must be a DNS label or because the
+// controllers managing them derive the names of other objects or labels by appending a generated suffix.
+var maxNameLengths = map[schema.GroupKind]int{
+	{Kind: "Namespace"}: 63,
+	{Kind: "Service"}:   63,
+	// the "controller-revision-hash" label of the pods is the name followed by a dash and a 10 characters hash
+	{Group: "apps", Kind: "StatefulSet"}: 52,
+	// the Jobs are named after the CronJob followed by a dash and their scheduled time
+	{Group: "batch", Kind: "CronJob"}: 52,
+}
+
+// NameTooLongError is returned when the name of a rendered resource exceeds the limit of its kind.
+type NameTooLongError struct {
+	Resource string
+	Length   int
+	Max      int
+}
+
+func (e *NameTooLongError) Error() string {
+	return fmt.Sprintf("name of %s is %d characters long, more than the maximum of %d", e.Resource, e.Length, e.Max)
+}
+
+// NameLengthPlugin fails the rendering when the name of a resource is too long for its kind, e.g. after a
+// name prefix or suffix was added, instead of having it rejected by the API server once partially applied.
+type NameLengthPlugin struct{}
+
+var _ resmap.Transformer = &NameLengthPlugin{}
+
+// CreateNameLengthPlugin creates a plugin validating the length of the resource names.
+func CreateNameLengthPlugin() *NameLengthPlugin {
+	return &NameLengthPlugin{}
+}
+
+// Transform returns a NameTooLongError for the first resource of the ResMap whose name is too long.
+// Resources are not modified.
+func (p *NameLengthPlugin) Transform(m resmap.ResMap) error {
+	for _, res := range m.Resources() {
+		limit, ok := maxNameLengths[schema.GroupKind{Group: res.GetGvk().Group, Kind: res.GetKind()}]
+		if !ok {
+			limit = maxNameLength
+		}
+
+		if length := len(res.GetName()); length > limit {
+			return &NameTooLongError{Resource: res.CurId().String(), Length: length, Max: limit}
+		}
+	}
+
+	return nil
+}
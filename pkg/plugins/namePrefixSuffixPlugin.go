@@ -0,0 +1,111 @@
+package plugins
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kustomize/api/builtins" //nolint:staticcheck // Remove after package update
+	"sigs.k8s.io/kustomize/api/filters/nameref"
+	"sigs.k8s.io/kustomize/api/konfig/builtinpluginconsts"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	"sigs.k8s.io/yaml"
+)
+
+// NamePrefixSuffixPlugin renames the resources of a ResMap by adding a prefix and/or a suffix
+// to their names, and rewrites name references between the resources of the same ResMap
+// (e.g. a ConfigMap mounted by a Deployment, or the ServiceAccount used by its pods) accordingly.
+type NamePrefixSuffixPlugin struct {
+	Prefix string
+	Suffix string
+}
+
+var _ resmap.Transformer = &NamePrefixSuffixPlugin{}
+
+// CreateNamePrefixPlugin creates a plugin which adds the given prefix to resource names.
+func CreateNamePrefixPlugin(prefix string) *NamePrefixSuffixPlugin {
+	return &NamePrefixSuffixPlugin{
+		Prefix: prefix,
+	}
+}
+
+// CreateNameSuffixPlugin creates a plugin which adds the given suffix to resource names.
+func CreateNameSuffixPlugin(suffix string) *NamePrefixSuffixPlugin {
+	return &NamePrefixSuffixPlugin{
+		Suffix: suffix,
+	}
+}
+
+// Transform renames all the resources of the ResMap and fixes the references between them.
+func (p *NamePrefixSuffixPlugin) Transform(m resmap.ResMap) error {
+	nameFieldSpecs := []types.FieldSpec{
+		{
+			Gvk:  resid.Gvk{},
+			Path: "metadata/name",
+		},
+	}
+
+	if p.Prefix != "" {
+		prefixPlugin := builtins.PrefixTransformerPlugin{
+			Prefix:     p.Prefix,
+			FieldSpecs: nameFieldSpecs,
+		}
+		if err := prefixPlugin.Transform(m); err != nil {
+			return fmt.Errorf("failed adding name prefix %q: %w", p.Prefix, err)
+		}
+	}
+
+	if p.Suffix != "" {
+		suffixPlugin := builtins.SuffixTransformerPlugin{
+			Suffix:     p.Suffix,
+			FieldSpecs: nameFieldSpecs,
+		}
+		if err := suffixPlugin.Transform(m); err != nil {
+			return fmt.Errorf("failed adding name suffix %q: %w", p.Suffix, err)
+		}
+	}
+
+	return fixNameReferences(m)
+}
+
+// nameBackReference mirrors kustomize's internal representation of the "nameReference" configuration:
+// a referral target and the list of fields through which other resources may refer to it by name.
+type nameBackReference struct {
+	resid.Gvk `json:",inline"`
+	Referrers types.FsSlice `json:"fieldSpecs,omitempty"`
+}
+
+// fixNameReferences updates the fields holding the name of another resource of the ResMap, using the
+// default kustomize name reference configuration, the same way `kustomize build` does after renames.
+func fixNameReferences(m resmap.ResMap) error {
+	config := struct {
+		NameReference []nameBackReference `json:"nameReference"`
+	}{}
+	if err := yaml.Unmarshal([]byte(builtinpluginconsts.GetDefaultFieldSpecsAsMap()["namereference"]), &config); err != nil {
+		return fmt.Errorf("failed parsing default name reference configuration: %w", err)
+	}
+
+	for _, res := range m.Resources() {
+		candidates, err := m.SubsetThatCouldBeReferencedByResource(res)
+		if err != nil {
+			return err
+		}
+		for _, backRef := range config.NameReference {
+			for _, referrer := range backRef.Referrers {
+				if !res.OrgId().IsSelected(&referrer.Gvk) {
+					continue
+				}
+				if err := res.ApplyFilter(nameref.Filter{
+					Referrer:           res,
+					NameFieldToUpdate:  referrer,
+					ReferralTarget:     backRef.Gvk,
+					ReferralCandidates: candidates,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package plugins_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Name length plugin", func() {
+	const service = "apiVersion: v1\nkind: Service\nmetadata:\n  name: component\n"
+
+	It("Should fail when a prefix makes a name longer than the limit of its kind", func() {
+		resMap := newResMap(managedDeployment, service)
+		Expect(plugins.CreateNamePrefixPlugin(strings.Repeat("a", 60) + "-").Transform(resMap)).To(Succeed())
+
+		err := plugins.CreateNameLengthPlugin().Transform(resMap)
+
+		var nameTooLong *plugins.NameTooLongError
+		Expect(errors.As(err, &nameTooLong)).To(BeTrue())
+		Expect(nameTooLong.Resource).To(ContainSubstring("Service"))
+		Expect(nameTooLong.Length).To(Equal(70))
+		Expect(nameTooLong.Max).To(Equal(63))
+	})
+
+	It("Should accept names within the limits", func() {
+		resMap := newResMap(managedDeployment, service)
+		Expect(plugins.CreateNamePrefixPlugin(strings.Repeat("a", 40) + "-").Transform(resMap)).To(Succeed())
+
+		Expect(plugins.CreateNameLengthPlugin().Transform(resMap)).To(Succeed())
+	})
+})
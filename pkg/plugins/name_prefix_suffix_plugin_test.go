@@ -0,0 +1,92 @@
+package plugins_test
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const componentResources = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: component-config
+data:
+  key: value
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: component-sa
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: component
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: component
+  template:
+    metadata:
+      labels:
+        app: component
+    spec:
+      serviceAccountName: component-sa
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+        volumeMounts:
+        - name: config
+          mountPath: /etc/config
+      volumes:
+      - name: config
+        configMap:
+          name: component-config
+`
+
+var _ = Describe("Name prefix/suffix plugin", func() {
+	var resMap resmap.ResMap
+
+	BeforeEach(func() {
+		var err error
+		resMap, err = resMapFactory.NewResMapFromBytes([]byte(componentResources))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should add the prefix to names and fix references to renamed resources", func() {
+		Expect(plugins.CreateNamePrefixPlugin("second-").Transform(resMap)).To(Succeed())
+
+		Expect(resMap.Resources()).To(HaveLen(3))
+		for _, res := range resMap.Resources() {
+			Expect(res.GetName()).To(HavePrefix("second-"))
+		}
+
+		deployment, err := resMap.GetById(resid.NewResId(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "second-component"))
+		Expect(err).NotTo(HaveOccurred())
+
+		configMapName, err := deployment.GetString("spec.template.spec.volumes[0].configMap.name")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configMapName).To(Equal("second-component-config"))
+
+		serviceAccountName, err := deployment.GetString("spec.template.spec.serviceAccountName")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceAccountName).To(Equal("second-component-sa"))
+	})
+
+	It("Should add the suffix to names and fix references to renamed resources", func() {
+		Expect(plugins.CreateNameSuffixPlugin("-b").Transform(resMap)).To(Succeed())
+
+		deployment, err := resMap.GetById(resid.NewResId(resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}, "component-b"))
+		Expect(err).NotTo(HaveOccurred())
+
+		configMapName, err := deployment.GetString("spec.template.spec.volumes[0].configMap.name")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configMapName).To(Equal("component-config-b"))
+	})
+})
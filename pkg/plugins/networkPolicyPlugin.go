@@ -0,0 +1,83 @@
+package plugins
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// NetworkPolicyPlugin adds a NetworkPolicy selecting the pods of a component to the resources, i.e. the pods
+// labeled with the component label set by CreateAddLabelsPlugin. As the policy selects the pods, all the traffic
+// not allowed by its rules is denied. The NetworkPolicy is only added when the cluster serves the
+// networking.k8s.io API, as checked through Discovery when set.
+type NetworkPolicyPlugin struct {
+	ComponentName string
+	Namespace     string
+	Spec          networkingv1.NetworkPolicySpec
+	Discovery     discovery.DiscoveryInterface
+}
+
+var _ resmap.Transformer = &NetworkPolicyPlugin{}
+
+// CreateNetworkPolicyPlugin creates a plugin adding a NetworkPolicy with the given rules for the given component,
+// in the given namespace. The pod selector of the spec is replaced by the component one and the policy types
+// default to "Ingress".
+func CreateNetworkPolicyPlugin(cli discovery.DiscoveryInterface, componentName, namespace string,
+	spec networkingv1.NetworkPolicySpec) *NetworkPolicyPlugin {
+	return &NetworkPolicyPlugin{
+		ComponentName: componentName,
+		Namespace:     namespace,
+		Spec:          spec,
+		Discovery:     cli,
+	}
+}
+
+// Transform appends the NetworkPolicy to the ResMap.
+func (p *NetworkPolicyPlugin) Transform(m resmap.ResMap) error {
+	if p.Discovery != nil {
+		served, err := isGroupVersionServed(p.Discovery, networkingv1.SchemeGroupVersion.String())
+		if err != nil || !served {
+			return err
+		}
+	}
+
+	spec := *p.Spec.DeepCopy()
+	spec.PodSelector = metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			labels.ODH.Component(p.ComponentName): "true",
+		},
+	}
+	if len(spec.PolicyTypes) == 0 {
+		spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	}
+
+	networkPolicy := &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      p.ComponentName,
+			Namespace: p.Namespace,
+			Labels: map[string]string{
+				labels.ODH.Component(p.ComponentName): "true",
+				labels.K8SCommon.PartOf:               p.ComponentName,
+			},
+		},
+		Spec: spec,
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(networkPolicy)
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+
+	return m.Append(provider.NewDefaultDepProvider().GetResourceFactory().FromMap(content))
+}
@@ -0,0 +1,61 @@
+package plugins_test
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetworkPolicy plugin", func() {
+	spec := networkingv1.NetworkPolicySpec{
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{
+			From: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": "openshift-monitoring"},
+				},
+			}},
+		}},
+	}
+
+	It("Should add a NetworkPolicy selecting the component pods", func() {
+		resMap := newResMap(managedDeployment)
+		Expect(plugins.CreateAddLabelsPlugin("kueue").Transform(resMap)).To(Succeed())
+
+		networkPolicyPlugin := plugins.CreateNetworkPolicyPlugin(discoveryFor("apps/v1", "networking.k8s.io/v1"),
+			"kueue", "opendatahub", spec)
+		Expect(networkPolicyPlugin.Transform(resMap)).To(Succeed())
+
+		res, err := resMap.GetById(resid.NewResIdWithNamespace(
+			resid.Gvk{Group: "networking.k8s.io", Version: "v1", Kind: "NetworkPolicy"}, "kueue", "opendatahub"))
+		Expect(err).NotTo(HaveOccurred())
+
+		networkPolicy := networkingv1.NetworkPolicy{}
+		Expect(yaml.Unmarshal([]byte(res.MustYaml()), &networkPolicy)).To(Succeed())
+		Expect(networkPolicy.Spec.PolicyTypes).To(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeIngress}))
+		Expect(networkPolicy.Spec.Ingress).To(Equal(spec.Ingress))
+
+		template, err := getResource(resMap, gvk.Deployment, "managed").Pipe(kyaml.Lookup("spec", "template"))
+		Expect(err).NotTo(HaveOccurred())
+		selector, err := metav1.LabelSelectorAsSelector(&networkPolicy.Spec.PodSelector)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector.Matches(labels.Set(template.GetLabels()))).To(BeTrue())
+	})
+
+	It("Should not add a NetworkPolicy when the networking API is not served", func() {
+		resMap := newResMap(managedDeployment)
+
+		networkPolicyPlugin := plugins.CreateNetworkPolicyPlugin(discoveryFor("apps/v1"), "kueue", "opendatahub", spec)
+		Expect(networkPolicyPlugin.Transform(resMap)).To(Succeed())
+
+		Expect(resMap.Resources()).To(HaveLen(1))
+	})
+})
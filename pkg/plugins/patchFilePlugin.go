@@ -0,0 +1,65 @@
+package plugins
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// PatchFilePlugin applies a strategic merge patch read from a file of the manifests filesystem.
+// Keeping the patch next to the manifests allows it to be versioned with them.
+type PatchFilePlugin struct {
+	FileSystem filesys.FileSystem
+	// Target selects the resources to patch, when nil the resource matching the patch identifier is patched.
+	Target *types.Selector
+	Path   string
+}
+
+var _ resmap.Transformer = &PatchFilePlugin{}
+
+// CreatePatchFilePlugin creates a plugin applying the patch stored at path in fs to the selected resources.
+func CreatePatchFilePlugin(fs filesys.FileSystem, target *types.Selector, path string) *PatchFilePlugin {
+	return &PatchFilePlugin{
+		FileSystem: fs,
+		Target:     target,
+		Path:       path,
+	}
+}
+
+// Transform applies the patch to the ResMap. An empty patch file leaves the resources untouched.
+func (p *PatchFilePlugin) Transform(m resmap.ResMap) error {
+	content, err := p.FileSystem.ReadFile(p.Path)
+	if err != nil {
+		return fmt.Errorf("failed reading patch file %s: %w", p.Path, err)
+	}
+
+	if strings.TrimSpace(string(content)) == "" {
+		return nil
+	}
+
+	patch, err := provider.NewDefaultDepProvider().GetResourceFactory().FromBytes(content)
+	if err != nil {
+		return fmt.Errorf("failed parsing patch file %s: %w", p.Path, err)
+	}
+
+	if p.Target == nil {
+		target, err := m.GetById(patch.OrgId())
+		if err != nil {
+			return fmt.Errorf("failed finding the resource patched by %s: %w", p.Path, err)
+		}
+
+		return target.ApplySmPatch(patch)
+	}
+
+	selected, err := m.Select(*p.Target)
+	if err != nil {
+		return err
+	}
+
+	return m.ApplySmPatch(resource.MakeIdSet(selected), patch)
+}
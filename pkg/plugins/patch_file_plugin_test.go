@@ -0,0 +1,63 @@
+package plugins_test
+
+import (
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Patch file plugin", func() {
+	var fs filesys.FileSystem
+
+	BeforeEach(func() {
+		fs = filesys.MakeFsInMemory()
+		Expect(fs.WriteFile("/manifests/patches/replicas.yaml", []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: managed
+spec:
+  replicas: 5
+`))).To(Succeed())
+		Expect(fs.WriteFile("/manifests/patches/empty.yaml", []byte("\n"))).To(Succeed())
+	})
+
+	It("Should apply the patch read from the manifests filesystem", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		Expect(plugins.CreatePatchFilePlugin(fs, nil, "/manifests/patches/replicas.yaml").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.replicas")).To(Equal(5))
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").MustYaml()).To(MatchYAML(componentConfigMap))
+	})
+
+	It("Should apply the patch to the selected resources", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		target := &types.Selector{ResId: resid.ResId{Gvk: resid.Gvk{Group: "apps", Kind: "Deployment"}}}
+		Expect(plugins.CreatePatchFilePlugin(fs, target, "/manifests/patches/replicas.yaml").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.replicas")).To(Equal(5))
+	})
+
+	It("Should leave the resources untouched when the patch is empty", func() {
+		resMap := newResMap(managedDeployment)
+
+		Expect(plugins.CreatePatchFilePlugin(fs, nil, "/manifests/patches/empty.yaml").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").MustYaml()).To(MatchYAML(managedDeployment))
+	})
+
+	It("Should fail when the patch file is missing", func() {
+		resMap := newResMap(managedDeployment)
+
+		Expect(plugins.CreatePatchFilePlugin(fs, nil, "/manifests/patches/missing.yaml").Transform(resMap)).To(
+			MatchError(ContainSubstring("/manifests/patches/missing.yaml")))
+	})
+})
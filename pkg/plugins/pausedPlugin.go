@@ -0,0 +1,36 @@
+package plugins
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// PausedPlugin sets "spec/paused" on Deployments, e.g. to apply them during a maintenance without triggering
+// a rollout until they are unpaused.
+type PausedPlugin struct {
+	Paused bool
+}
+
+var _ resmap.Transformer = &PausedPlugin{}
+
+// CreatePausedPlugin creates a plugin pausing, or unpausing, the rendered Deployments.
+func CreatePausedPlugin(paused bool) *PausedPlugin {
+	return &PausedPlugin{
+		Paused: paused,
+	}
+}
+
+// Transform sets "spec/paused" on the Deployments of the ResMap, overriding the value of the manifests.
+func (p *PausedPlugin) Transform(m resmap.ResMap) error {
+	value := kyaml.NewScalarRNode(strconv.FormatBool(p.Paused))
+	value.YNode().Tag = kyaml.NodeTagBool
+
+	return transformKinds(m, []schema.GroupVersionKind{gvk.Deployment}, func(node *kyaml.RNode) error {
+		return node.PipeE(kyaml.LookupCreate(kyaml.MappingNode, "spec"), kyaml.SetField("paused", value))
+	})
+}
@@ -0,0 +1,29 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Paused plugin", func() {
+	It("Should pause the Deployments", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		Expect(plugins.CreatePausedPlugin(true).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.paused")).To(BeTrue())
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").GetString("spec.paused")).Error().To(HaveOccurred())
+	})
+
+	It("Should unpause paused Deployments", func() {
+		resMap := newResMap(managedDeployment)
+
+		Expect(plugins.CreatePausedPlugin(true).Transform(resMap)).To(Succeed())
+		Expect(plugins.CreatePausedPlugin(false).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.paused")).To(BeFalse())
+	})
+})
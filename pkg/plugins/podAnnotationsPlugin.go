@@ -0,0 +1,55 @@
+package plugins
+
+import (
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// PodAnnotationsPlugin sets annotations on the pod template of workloads, e.g. the Prometheus scrape ones,
+// as opposed to the annotations of the workload object itself. Annotations already set on a pod template
+// are overwritten, unless FailOnConflict is set in which case a different value is an error.
+type PodAnnotationsPlugin struct {
+	Annotations    map[string]string
+	FailOnConflict bool
+}
+
+var _ resmap.Transformer = &PodAnnotationsPlugin{}
+
+// CreatePodAnnotationsPlugin creates a plugin setting the given pod template annotations.
+func CreatePodAnnotationsPlugin(annotations map[string]string) *PodAnnotationsPlugin {
+	return &PodAnnotationsPlugin{
+		Annotations: annotations,
+	}
+}
+
+// Transform sets the annotations on the pod template of the workloads of the ResMap.
+func (p *PodAnnotationsPlugin) Transform(m resmap.ResMap) error {
+	keys := make([]string, 0, len(p.Annotations))
+	for key := range p.Annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return transformPodTemplates(m, nil, func(template *kyaml.RNode) error {
+		podAnnotations, err := template.Pipe(kyaml.LookupCreate(kyaml.MappingNode, "metadata", "annotations"))
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			value := p.Annotations[key]
+			if existing := podAnnotations.Field(key); p.FailOnConflict && existing != nil && kyaml.GetValue(existing.Value) != value {
+				return fmt.Errorf("pod annotation %s is already set to %q, cannot set it to %q",
+					key, kyaml.GetValue(existing.Value), value)
+			}
+			if err := podAnnotations.PipeE(kyaml.SetField(key, kyaml.NewStringRNode(value))); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
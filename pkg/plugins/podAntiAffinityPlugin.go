@@ -0,0 +1,91 @@
+package plugins
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// podAntiAffinityWeight is the weight of the preferred anti-affinity rule, the highest one allowed.
+const podAntiAffinityWeight = 100
+
+// multiReplicaWorkloads are the workload kinds which can run several replicas of the same pod.
+var multiReplicaWorkloads = []schema.GroupVersionKind{
+	gvk.Deployment,
+	gvk.StatefulSet,
+}
+
+// PodAntiAffinityPlugin adds a preferred pod anti-affinity rule to workloads running more than one replica,
+// to spread the replicas across the nodes or zones identified by TopologyKey. The rule selects the pods of the
+// workload by the label LabelKey, which is set on the pod template to the workload name when missing.
+type PodAntiAffinityPlugin struct {
+	TopologyKey string
+	LabelKey    string
+}
+
+var _ resmap.Transformer = &PodAntiAffinityPlugin{}
+
+// CreatePodAntiAffinityPlugin creates a plugin spreading the replicas of workloads over the given topology key.
+func CreatePodAntiAffinityPlugin(topologyKey, labelKey string) *PodAntiAffinityPlugin {
+	return &PodAntiAffinityPlugin{
+		TopologyKey: topologyKey,
+		LabelKey:    labelKey,
+	}
+}
+
+// Transform adds the anti-affinity rule to the multi-replica workloads of the ResMap which do not define
+// a preferred anti-affinity rule for the same topology key yet.
+func (p *PodAntiAffinityPlugin) Transform(m resmap.ResMap) error {
+	return transformKinds(m, multiReplicaWorkloads, func(node *kyaml.RNode) error {
+		replicas, err := node.Pipe(kyaml.Lookup("spec", "replicas"))
+		if err != nil || replicas == nil || replicas.YNode().Value == "0" || replicas.YNode().Value == "1" {
+			return err
+		}
+
+		template, err := node.Pipe(kyaml.LookupCreate(kyaml.MappingNode, "spec", "template"))
+		if err != nil {
+			return err
+		}
+		if err := setFieldIfUnset(template, kyaml.NewStringRNode(node.GetName()), p.LabelKey, "metadata", "labels"); err != nil {
+			return err
+		}
+		labelValue, err := template.Pipe(kyaml.Lookup("metadata", "labels", p.LabelKey))
+		if err != nil {
+			return err
+		}
+
+		terms, err := template.Pipe(kyaml.LookupCreate(kyaml.SequenceNode,
+			"spec", "affinity", "podAntiAffinity", "preferredDuringSchedulingIgnoredDuringExecution"))
+		if err != nil {
+			return err
+		}
+		for _, elem := range terms.Content() {
+			topologyKey, err := kyaml.NewRNode(elem).Pipe(kyaml.Lookup("podAffinityTerm", "topologyKey"))
+			if err != nil {
+				return err
+			}
+			if topologyKey != nil && kyaml.GetValue(topologyKey) == p.TopologyKey {
+				return nil
+			}
+		}
+
+		term, err := toRNode(corev1.WeightedPodAffinityTerm{
+			Weight: podAntiAffinityWeight,
+			PodAffinityTerm: corev1.PodAffinityTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{p.LabelKey: kyaml.GetValue(labelValue)},
+				},
+				TopologyKey: p.TopologyKey,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		return terms.PipeE(kyaml.Append(term.YNode()))
+	})
+}
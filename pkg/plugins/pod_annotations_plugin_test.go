@@ -0,0 +1,53 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const annotatedPodsDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: annotated
+spec:
+  template:
+    metadata:
+      annotations:
+        prometheus.io/port: "9090"
+`
+
+var _ = Describe("Pod annotations plugin", func() {
+	scrapeAnnotations := map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "8080",
+	}
+
+	It("Should add the annotations to the pod template only", func() {
+		resMap := newResMap(managedDeployment, annotatedPodsDeployment)
+
+		Expect(plugins.CreatePodAnnotationsPlugin(scrapeAnnotations).Transform(resMap)).To(Succeed())
+
+		managed := getResource(resMap, gvk.Deployment, "managed")
+		Expect(managed.GetFieldValue("spec.template.metadata.annotations")).To(Equal(map[string]interface{}{
+			"prometheus.io/scrape": "true",
+			"prometheus.io/port":   "8080",
+		}))
+		Expect(managed.GetAnnotations()).To(BeEmpty())
+
+		Expect(getResource(resMap, gvk.Deployment, "annotated").GetString("spec.template.metadata.annotations.prometheus\\.io/port")).
+			To(Equal("8080"))
+	})
+
+	It("Should fail on a conflicting annotation when requested", func() {
+		resMap := newResMap(annotatedPodsDeployment)
+
+		podAnnotationsPlugin := plugins.CreatePodAnnotationsPlugin(scrapeAnnotations)
+		podAnnotationsPlugin.FailOnConflict = true
+
+		Expect(podAnnotationsPlugin.Transform(resMap)).To(MatchError(ContainSubstring("prometheus.io/port")))
+	})
+})
@@ -0,0 +1,46 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pod anti-affinity plugin", func() {
+	It("Should spread the replicas of multi-replica workloads only", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: single
+spec:
+  replicas: 1
+`)
+
+		antiAffinityPlugin := plugins.CreatePodAntiAffinityPlugin("kubernetes.io/hostname", "app.opendatahub.io/workload")
+		Expect(antiAffinityPlugin.Transform(resMap)).To(Succeed())
+		Expect(antiAffinityPlugin.Transform(resMap)).To(Succeed())
+
+		managed := getResource(resMap, gvk.Deployment, "managed")
+		Expect(managed.GetFieldValue("spec.template.metadata.labels")).To(Equal(map[string]interface{}{
+			"app":                         "managed",
+			"app.opendatahub.io/workload": "managed",
+		}))
+		Expect(managed.GetFieldValue("spec.template.spec.affinity.podAntiAffinity.preferredDuringSchedulingIgnoredDuringExecution")).
+			To(Equal([]interface{}{
+				map[string]interface{}{
+					"weight": 100,
+					"podAffinityTerm": map[string]interface{}{
+						"labelSelector": map[string]interface{}{
+							"matchLabels": map[string]interface{}{"app.opendatahub.io/workload": "managed"},
+						},
+						"topologyKey": "kubernetes.io/hostname",
+					},
+				},
+			}))
+
+		Expect(getResource(resMap, gvk.Deployment, "single").GetFieldValue("spec.template")).Error().To(HaveOccurred())
+	})
+})
@@ -0,0 +1,38 @@
+package plugins
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/kustomize/api/builtins" //nolint:staticcheck // Remove after package update
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// CreateReconcileIDPlugin creates an annotation transformer plugin stamping resources with the identifier
+// of the current reconcile. The identifier is the "reconcileID" controller-runtime adds to the reconcile logs,
+// so that all resources touched by one reconcile can be matched with its logs. Outside a reconcile a new
+// identifier is generated. DeployManifestsFromPath keeps the identifier of the live resources whose rendered
+// content did not change, so the identifier tells the last reconcile which changed a resource.
+func CreateReconcileIDPlugin(ctx context.Context) *builtins.AnnotationsTransformerPlugin {
+	id := controller.ReconcileIDFromContext(ctx)
+	if id == "" {
+		id = uuid.NewUUID()
+	}
+
+	return &builtins.AnnotationsTransformerPlugin{
+		Annotations: map[string]string{
+			annotations.ReconcileID: string(id),
+		},
+		FieldSpecs: []types.FieldSpec{
+			{
+				Gvk:                resid.Gvk{},
+				Path:               "metadata/annotations",
+				CreateIfNotPresent: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package plugins_test
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reconcile ID plugin", func() {
+	It("Should stamp all resources with the same reconcile ID", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		Expect(plugins.CreateReconcileIDPlugin(context.Background()).Transform(resMap)).To(Succeed())
+
+		Expect(resMap.Resources()).To(HaveLen(2))
+		id := resMap.Resources()[0].GetAnnotations()[annotations.ReconcileID]
+		Expect(id).NotTo(BeEmpty())
+		for _, res := range resMap.Resources() {
+			Expect(res.GetAnnotations()).To(HaveKeyWithValue(annotations.ReconcileID, id))
+		}
+	})
+
+	It("Should generate a new ID for every plugin created outside a reconcile", func() {
+		first := plugins.CreateReconcileIDPlugin(context.Background()).Annotations[annotations.ReconcileID]
+		second := plugins.CreateReconcileIDPlugin(context.Background()).Annotations[annotations.ReconcileID]
+
+		Expect(first).NotTo(Equal(second))
+	})
+})
@@ -0,0 +1,55 @@
+package plugins
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// ReplicaClampPlugin clamps "spec/replicas" of Deployments and StatefulSets into [Min, Max], e.g. to keep a typo
+// in the manifests from deploying hundreds of replicas. Workloads which do not define replicas are left untouched.
+type ReplicaClampPlugin struct {
+	Min int32
+	Max int32
+}
+
+var _ resmap.Transformer = &ReplicaClampPlugin{}
+
+// CreateReplicaClampPlugin creates a plugin clamping the replicas into the given range.
+func CreateReplicaClampPlugin(minReplicas, maxReplicas int32) (*ReplicaClampPlugin, error) {
+	if minReplicas < 0 || maxReplicas < minReplicas {
+		return nil, fmt.Errorf("invalid replicas range [%d, %d]", minReplicas, maxReplicas)
+	}
+
+	return &ReplicaClampPlugin{
+		Min: minReplicas,
+		Max: maxReplicas,
+	}, nil
+}
+
+// Transform clamps the replicas of the Deployments and StatefulSets of the ResMap.
+func (p *ReplicaClampPlugin) Transform(m resmap.ResMap) error {
+	return transformKinds(m, []schema.GroupVersionKind{gvk.Deployment, gvk.StatefulSet}, func(node *kyaml.RNode) error {
+		field, err := node.Pipe(kyaml.Lookup("spec", "replicas"))
+		if err != nil || field == nil {
+			return err
+		}
+
+		replicas, err := strconv.ParseInt(kyaml.GetValue(field), 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid replicas: %w", err)
+		}
+
+		clamped := min(max(int32(replicas), p.Min), p.Max)
+		if clamped == int32(replicas) {
+			return nil
+		}
+
+		return node.PipeE(kyaml.Lookup("spec"), kyaml.SetField("replicas", newIntRNode(int64(clamped))))
+	})
+}
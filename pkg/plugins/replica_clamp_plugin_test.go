@@ -0,0 +1,44 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Replica clamp plugin", func() {
+	It("Should clamp the replicas into the range", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: single
+spec:
+  replicas: 0
+`)
+
+		plugin, err := plugins.CreateReplicaClampPlugin(1, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plugin.Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.replicas")).To(Equal(2))
+		Expect(getResource(resMap, gvk.StatefulSet, "single").GetFieldValue("spec.replicas")).To(Equal(1))
+	})
+
+	It("Should keep the replicas within the range", func() {
+		resMap := newResMap(managedDeployment)
+
+		plugin, err := plugins.CreateReplicaClampPlugin(1, 5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plugin.Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.replicas")).To(Equal(3))
+	})
+
+	It("Should reject an empty range", func() {
+		_, err := plugins.CreateReplicaClampPlugin(3, 2)
+		Expect(err).To(HaveOccurred())
+	})
+})
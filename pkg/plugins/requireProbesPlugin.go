@@ -0,0 +1,93 @@
+package plugins
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// requiredProbes are the probes every long running container must define.
+var requiredProbes = []string{"livenessProbe", "readinessProbe"}
+
+// RequireProbesPlugin reports the containers of Deployments, StatefulSets and DaemonSets missing a liveness or
+// readiness probe on the managed platform. The findings are logged and kept in Missing, or fail the rendering
+// when Strict is set. Init containers and Jobs are not checked, as they run to completion.
+type RequireProbesPlugin struct {
+	Enabled bool
+	Strict  bool
+	Missing []string
+}
+
+var _ resmap.Transformer = &RequireProbesPlugin{}
+
+// CreateRequireProbesPlugin creates a plugin reporting missing probes on the managed platform only.
+func CreateRequireProbesPlugin(platform cluster.Platform) *RequireProbesPlugin {
+	return &RequireProbesPlugin{
+		Enabled: platform == cluster.ManagedRhoai,
+	}
+}
+
+// CreateStrictRequireProbesPlugin creates a plugin failing on missing probes on the managed platform only.
+func CreateStrictRequireProbesPlugin(platform cluster.Platform) *RequireProbesPlugin {
+	return &RequireProbesPlugin{
+		Enabled: platform == cluster.ManagedRhoai,
+		Strict:  true,
+	}
+}
+
+// Transform records the containers of the ResMap missing probes, and returns an error listing them when strict.
+// Resources are not modified.
+func (p *RequireProbesPlugin) Transform(m resmap.ResMap) error {
+	p.Missing = nil
+	if !p.Enabled {
+		return nil
+	}
+
+	for _, res := range m.Resources() {
+		resGvk := resourceGvk(res.GetGvk().Group, res.GetGvk().Version, res.GetGvk().Kind)
+		if !slices.Contains(appsWorkloads, resGvk) {
+			continue
+		}
+
+		containers, err := res.Pipe(kyaml.Lookup(slices.Concat(podTemplatePaths[resGvk], []string{"spec", "containers"})...))
+		if err != nil {
+			return fmt.Errorf("failed looking up containers of %s: %w", res.CurId(), err)
+		}
+		if containers == nil {
+			continue
+		}
+
+		err = containers.VisitElements(func(container *kyaml.RNode) error {
+			name, err := container.GetString("name")
+			if err != nil {
+				return err
+			}
+			for _, probe := range requiredProbes {
+				if field := container.Field(probe); field == nil || kyaml.IsMissingOrNull(field.Value) {
+					p.Missing = append(p.Missing, fmt.Sprintf("%s/%s/%s: %s", res.GetKind(), res.GetName(), name, probe))
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed reading probes of %s: %w", res.CurId(), err)
+		}
+	}
+
+	if len(p.Missing) == 0 {
+		return nil
+	}
+	if p.Strict {
+		return fmt.Errorf("containers must define probes, found missing %s", strings.Join(p.Missing, ", "))
+	}
+	ctrl.Log.Info("containers missing probes", "missing", p.Missing)
+
+	return nil
+}
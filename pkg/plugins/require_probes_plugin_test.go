@@ -0,0 +1,60 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const probedDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: probed
+spec:
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+        livenessProbe:
+          httpGet:
+            path: /healthz
+            port: 80
+        readinessProbe:
+          httpGet:
+            path: /readyz
+            port: 80
+`
+
+var _ = Describe("Require probes plugin", func() {
+	It("Should report the containers missing probes in managed mode", func() {
+		resMap := newResMap(managedDeployment, probedDeployment, componentConfigMap)
+
+		plugin := plugins.CreateRequireProbesPlugin(cluster.ManagedRhoai)
+		Expect(plugin.Transform(resMap)).To(Succeed())
+
+		Expect(plugin.Missing).To(Equal([]string{
+			"Deployment/managed/nginx: livenessProbe",
+			"Deployment/managed/nginx: readinessProbe",
+		}))
+	})
+
+	It("Should fail on containers missing probes in managed mode when strict", func() {
+		resMap := newResMap(managedDeployment, probedDeployment)
+
+		err := plugins.CreateStrictRequireProbesPlugin(cluster.ManagedRhoai).Transform(resMap)
+		Expect(err).To(MatchError(ContainSubstring("Deployment/managed/nginx: livenessProbe")))
+		Expect(err).NotTo(MatchError(ContainSubstring("Deployment/probed")))
+	})
+
+	It("Should not check probes on other platforms", func() {
+		resMap := newResMap(managedDeployment)
+
+		plugin := plugins.CreateStrictRequireProbesPlugin(cluster.SelfManagedRhoai)
+		Expect(plugin.Transform(resMap)).To(Succeed())
+		Expect(plugin.Missing).To(BeEmpty())
+	})
+})
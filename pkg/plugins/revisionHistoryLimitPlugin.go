@@ -0,0 +1,28 @@
+package plugins
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// RevisionHistoryLimitPlugin sets "spec/revisionHistoryLimit" on Deployments, StatefulSets and DaemonSets
+// which do not define it, to limit the number of old ReplicaSets and revisions kept in the cluster.
+type RevisionHistoryLimitPlugin struct {
+	Limit int32
+}
+
+var _ resmap.Transformer = &RevisionHistoryLimitPlugin{}
+
+// CreateRevisionHistoryLimitPlugin creates a plugin setting the given revision history limit.
+func CreateRevisionHistoryLimitPlugin(limit int32) *RevisionHistoryLimitPlugin {
+	return &RevisionHistoryLimitPlugin{
+		Limit: limit,
+	}
+}
+
+// Transform sets the revision history limit on the workloads of the ResMap where unset.
+func (p *RevisionHistoryLimitPlugin) Transform(m resmap.ResMap) error {
+	return transformKinds(m, appsWorkloads, func(node *kyaml.RNode) error {
+		return setFieldIfUnset(node, newIntRNode(int64(p.Limit)), "revisionHistoryLimit", "spec")
+	})
+}
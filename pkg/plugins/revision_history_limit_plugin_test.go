@@ -0,0 +1,58 @@
+package plugins_test
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision history limit plugin", func() {
+	var resMap resmap.ResMap
+
+	BeforeEach(func() {
+		var err error
+		resMap, err = resMapFactory.NewResMapFromBytes([]byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: managed
+spec:
+  replicas: 3
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: author-defined
+spec:
+  revisionHistoryLimit: 2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: component-config
+`))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should set the limit where unset and keep the author defined one", func() {
+		Expect(plugins.CreateRevisionHistoryLimitPlugin(5).Transform(resMap)).To(Succeed())
+
+		deploymentGvk := resid.Gvk{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+		managed, err := resMap.GetById(resid.NewResId(deploymentGvk, "managed"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(managed.GetFieldValue("spec.revisionHistoryLimit")).To(Equal(5))
+
+		authorDefined, err := resMap.GetById(resid.NewResId(deploymentGvk, "author-defined"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authorDefined.GetFieldValue("spec.revisionHistoryLimit")).To(Equal(2))
+
+		configMap, err := resMap.GetById(resid.NewResId(resid.Gvk{Version: "v1", Kind: "ConfigMap"}, "component-config"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configMap.GetFieldValue("spec")).Error().To(HaveOccurred())
+	})
+})
@@ -0,0 +1,28 @@
+package plugins
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// RuntimeClassPlugin sets "runtimeClassName" on the pod template of workloads which do not define it,
+// e.g. the nvidia runtime for components running on GPU nodes.
+type RuntimeClassPlugin struct {
+	Name string
+}
+
+var _ resmap.Transformer = &RuntimeClassPlugin{}
+
+// CreateRuntimeClassPlugin creates a plugin setting the given runtime class.
+func CreateRuntimeClassPlugin(name string) *RuntimeClassPlugin {
+	return &RuntimeClassPlugin{
+		Name: name,
+	}
+}
+
+// Transform sets the runtime class on the workloads of the ResMap where unset.
+func (p *RuntimeClassPlugin) Transform(m resmap.ResMap) error {
+	return transformPodTemplates(m, nil, func(template *kyaml.RNode) error {
+		return setFieldIfUnset(template, kyaml.NewStringRNode(p.Name), "runtimeClassName", "spec")
+	})
+}
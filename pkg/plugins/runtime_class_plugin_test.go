@@ -0,0 +1,29 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Runtime class plugin", func() {
+	It("Should set the runtime class where unset and keep the author defined one", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: author-defined
+spec:
+  template:
+    spec:
+      runtimeClassName: kata
+`)
+
+		Expect(plugins.CreateRuntimeClassPlugin("nvidia").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetString("spec.template.spec.runtimeClassName")).To(Equal("nvidia"))
+		Expect(getResource(resMap, gvk.Deployment, "author-defined").GetString("spec.template.spec.runtimeClassName")).To(Equal("kata"))
+	})
+})
@@ -0,0 +1,58 @@
+package plugins
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// ServiceAccountNamePlugin sets "serviceAccountName" on the pod template of workloads, e.g. to run all pods
+// of a component with a shared ServiceAccount. By default only pod templates without one are updated, Override
+// forces it on all of them. The ServiceAccount itself is expected to come from the manifests, a warning is
+// logged when it is not part of the resources.
+type ServiceAccountNamePlugin struct {
+	Name     string
+	Override bool
+}
+
+var _ resmap.Transformer = &ServiceAccountNamePlugin{}
+
+// CreateServiceAccountNamePlugin creates a plugin setting the given ServiceAccount where unset.
+func CreateServiceAccountNamePlugin(name string) *ServiceAccountNamePlugin {
+	return &ServiceAccountNamePlugin{
+		Name: name,
+	}
+}
+
+// CreateServiceAccountNameOverridePlugin creates a plugin setting the given ServiceAccount on all workloads.
+func CreateServiceAccountNameOverridePlugin(name string) *ServiceAccountNamePlugin {
+	return &ServiceAccountNamePlugin{
+		Name:     name,
+		Override: true,
+	}
+}
+
+// Transform sets the ServiceAccount on the workloads of the ResMap.
+func (p *ServiceAccountNamePlugin) Transform(m resmap.ResMap) error {
+	found := false
+	for _, res := range m.Resources() {
+		if res.GetKind() == "ServiceAccount" && res.GetName() == p.Name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		ctrl.Log.Info("ServiceAccount set on workloads is not part of the rendered resources", "serviceAccountName", p.Name)
+	}
+
+	return transformPodTemplates(m, nil, func(template *kyaml.RNode) error {
+		name := kyaml.NewStringRNode(p.Name)
+		if p.Override {
+			return template.PipeE(
+				kyaml.LookupCreate(kyaml.MappingNode, "spec"),
+				kyaml.SetField("serviceAccountName", name))
+		}
+
+		return setFieldIfUnset(template, name, "serviceAccountName", "spec")
+	})
+}
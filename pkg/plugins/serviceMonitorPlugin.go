@@ -0,0 +1,96 @@
+package plugins
+
+import (
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// ServiceMonitorPlugin adds a ServiceMonitor scraping the Services of a component to the resources, i.e. the
+// Services labeled with the component label set by CreateAddLabelsPlugin. The ServiceMonitor is only added when
+// the cluster serves the monitoring.coreos.com API, as checked through Discovery when set.
+type ServiceMonitorPlugin struct {
+	ComponentName string
+	Namespace     string
+	Endpoint      monitoringv1.Endpoint
+	Discovery     discovery.DiscoveryInterface
+}
+
+var _ resmap.Transformer = &ServiceMonitorPlugin{}
+
+// CreateServiceMonitorPlugin creates a plugin adding a ServiceMonitor for the given component, in the given
+// namespace, scraping the given endpoint, e.g. the port, path and interval of the metrics of the component.
+func CreateServiceMonitorPlugin(cli discovery.DiscoveryInterface, componentName, namespace string,
+	endpoint monitoringv1.Endpoint) *ServiceMonitorPlugin {
+	return &ServiceMonitorPlugin{
+		ComponentName: componentName,
+		Namespace:     namespace,
+		Endpoint:      endpoint,
+		Discovery:     cli,
+	}
+}
+
+// Transform appends the ServiceMonitor to the ResMap.
+func (p *ServiceMonitorPlugin) Transform(m resmap.ResMap) error {
+	if p.Discovery != nil {
+		served, err := isGroupVersionServed(p.Discovery, monitoringv1.SchemeGroupVersion.String())
+		if err != nil || !served {
+			return err
+		}
+	}
+
+	componentLabels := map[string]string{
+		labels.ODH.Component(p.ComponentName): "true",
+	}
+	serviceMonitor := &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.ServiceMonitorsKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      p.ComponentName + "-metrics",
+			Namespace: p.Namespace,
+			Labels: map[string]string{
+				labels.ODH.Component(p.ComponentName): "true",
+				labels.K8SCommon.PartOf:               p.ComponentName,
+			},
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector:  metav1.LabelSelector{MatchLabels: componentLabels},
+			Endpoints: []monitoringv1.Endpoint{p.Endpoint},
+		},
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(serviceMonitor)
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+
+	return m.Append(provider.NewDefaultDepProvider().GetResourceFactory().FromMap(content))
+}
+
+func isGroupVersionServed(cli discovery.DiscoveryInterface, groupVersion string) (bool, error) {
+	groups, err := cli.ServerGroups()
+	if err != nil {
+		return false, fmt.Errorf("failed fetching server API groups: %w", err)
+	}
+
+	for _, group := range groups.Groups {
+		for _, version := range group.Versions {
+			if version.GroupVersion == groupVersion {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
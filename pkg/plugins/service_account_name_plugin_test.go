@@ -0,0 +1,40 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const authorServiceAccountDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: author-defined
+spec:
+  template:
+    spec:
+      serviceAccountName: component-sa
+`
+
+var _ = Describe("ServiceAccount name plugin", func() {
+	It("Should set the ServiceAccount where unset", func() {
+		resMap := newResMap(managedDeployment, authorServiceAccountDeployment)
+
+		Expect(plugins.CreateServiceAccountNamePlugin("shared-sa").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetString("spec.template.spec.serviceAccountName")).To(Equal("shared-sa"))
+		Expect(getResource(resMap, gvk.Deployment, "author-defined").GetString("spec.template.spec.serviceAccountName")).To(Equal("component-sa"))
+	})
+
+	It("Should force the ServiceAccount on all workloads when overriding", func() {
+		resMap := newResMap(managedDeployment, authorServiceAccountDeployment)
+
+		Expect(plugins.CreateServiceAccountNameOverridePlugin("shared-sa").Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetString("spec.template.spec.serviceAccountName")).To(Equal("shared-sa"))
+		Expect(getResource(resMap, gvk.Deployment, "author-defined").GetString("spec.template.spec.serviceAccountName")).To(Equal("shared-sa"))
+	})
+})
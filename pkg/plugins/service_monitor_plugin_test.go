@@ -0,0 +1,50 @@
+package plugins_test
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceMonitor plugin", func() {
+	endpoint := monitoringv1.Endpoint{
+		Port:     "metrics",
+		Path:     "/metrics",
+		Interval: "30s",
+	}
+
+	It("Should add a ServiceMonitor selecting the component Services", func() {
+		resMap := newResMap(managedDeployment)
+
+		serviceMonitorPlugin := plugins.CreateServiceMonitorPlugin(discoveryFor("apps/v1", "monitoring.coreos.com/v1"),
+			"kueue", "opendatahub", endpoint)
+		Expect(serviceMonitorPlugin.Transform(resMap)).To(Succeed())
+
+		res, err := resMap.GetById(resid.NewResIdWithNamespace(
+			resid.Gvk{Group: monitoringv1.SchemeGroupVersion.Group, Version: "v1", Kind: monitoringv1.ServiceMonitorsKind},
+			"kueue-metrics", "opendatahub"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.MustYaml()).NotTo(ContainSubstring("creationTimestamp"))
+
+		serviceMonitor := monitoringv1.ServiceMonitor{}
+		Expect(yaml.Unmarshal([]byte(res.MustYaml()), &serviceMonitor)).To(Succeed())
+
+		Expect(serviceMonitor.Namespace).To(Equal("opendatahub"))
+		Expect(serviceMonitor.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app.opendatahub.io/kueue": "true"}))
+		Expect(serviceMonitor.Spec.Endpoints).To(Equal([]monitoringv1.Endpoint{endpoint}))
+	})
+
+	It("Should not add a ServiceMonitor when the monitoring API is not served", func() {
+		resMap := newResMap(managedDeployment)
+
+		serviceMonitorPlugin := plugins.CreateServiceMonitorPlugin(discoveryFor("apps/v1"), "kueue", "opendatahub", endpoint)
+		Expect(serviceMonitorPlugin.Transform(resMap)).To(Succeed())
+
+		Expect(resMap.Resources()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,64 @@
+package plugins
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// SidecarPlugin adds a sidecar container to the pod template of workloads, along with the volumes it mounts.
+// Workloads already running a container, or defining a volume, with the same name are left as is.
+type SidecarPlugin struct {
+	Container corev1.Container
+	Volumes   []corev1.Volume
+	// Gvks restricts the workload kinds the sidecar is added to, all workloads when empty.
+	Gvks []schema.GroupVersionKind
+}
+
+var _ resmap.Transformer = &SidecarPlugin{}
+
+// CreateSidecarPlugin creates a plugin adding the given sidecar container to the workloads of the given kinds.
+func CreateSidecarPlugin(container corev1.Container, gvks ...schema.GroupVersionKind) *SidecarPlugin {
+	return &SidecarPlugin{
+		Container: container,
+		Gvks:      gvks,
+	}
+}
+
+// WithVolume adds a volume referenced by the sidecar volume mounts to the pod template of the workloads.
+func (p *SidecarPlugin) WithVolume(volume corev1.Volume) *SidecarPlugin {
+	p.Volumes = append(p.Volumes, volume)
+
+	return p
+}
+
+// Transform adds the sidecar container and its volumes to the workloads of the ResMap.
+func (p *SidecarPlugin) Transform(m resmap.ResMap) error {
+	container, err := toRNode(p.Container)
+	if err != nil {
+		return err
+	}
+
+	volumes := make([]*kyaml.RNode, 0, len(p.Volumes))
+	for _, volume := range p.Volumes {
+		node, err := toRNode(volume)
+		if err != nil {
+			return err
+		}
+		volumes = append(volumes, node)
+	}
+
+	return transformPodTemplates(m, p.Gvks, func(template *kyaml.RNode) error {
+		if err := appendIfMissing(template, container.Copy(), "name", "spec", "containers"); err != nil {
+			return err
+		}
+		for _, volume := range volumes {
+			if err := appendIfMissing(template, volume.Copy(), "name", "spec", "volumes"); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,55 @@
+package plugins_test
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar plugin", func() {
+	sidecar := corev1.Container{
+		Name:  "metrics-proxy",
+		Image: "metrics-proxy:latest",
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "proxy-tls", MountPath: "/etc/tls/private"},
+		},
+	}
+	volume := corev1.Volume{
+		Name: "proxy-tls",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "proxy-tls"},
+		},
+	}
+
+	It("Should add the sidecar and its volume to the workloads once", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		sidecarPlugin := plugins.CreateSidecarPlugin(sidecar, gvk.Deployment).WithVolume(volume)
+		Expect(sidecarPlugin.Transform(resMap)).To(Succeed())
+		Expect(sidecarPlugin.Transform(resMap)).To(Succeed())
+
+		deployment := appsv1.Deployment{}
+		Expect(yaml.Unmarshal([]byte(getResource(resMap, gvk.Deployment, "managed").MustYaml()), &deployment)).To(Succeed())
+
+		Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(2))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Name).To(Equal("nginx"))
+		Expect(deployment.Spec.Template.Spec.Containers[1]).To(Equal(sidecar))
+		Expect(deployment.Spec.Template.Spec.Volumes).To(Equal([]corev1.Volume{volume}))
+
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").MustYaml()).To(MatchYAML(componentConfigMap))
+	})
+
+	It("Should skip workloads of other kinds", func() {
+		resMap := newResMap(managedDeployment)
+
+		Expect(plugins.CreateSidecarPlugin(sidecar, gvk.StatefulSet).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").MustYaml()).To(MatchYAML(managedDeployment))
+	})
+})
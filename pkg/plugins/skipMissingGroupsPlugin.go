@@ -0,0 +1,94 @@
+package plugins
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+)
+
+// SkipMissingGroupsPlugin drops the resources whose API group version is not served by the cluster, e.g.
+// the ServiceMonitors of a component on a cluster without the monitoring stack, instead of failing to apply them.
+type SkipMissingGroupsPlugin struct {
+	Discovery discovery.DiscoveryInterface
+	// OnSkip, when set, is called with every dropped resource for the caller to report it.
+	OnSkip func(res *resource.Resource)
+}
+
+var _ resmap.Transformer = &SkipMissingGroupsPlugin{}
+
+// CreateSkipMissingGroupsPlugin creates a plugin checking the resource API groups against the given discovery client.
+func CreateSkipMissingGroupsPlugin(cli discovery.DiscoveryInterface) *SkipMissingGroupsPlugin {
+	return &SkipMissingGroupsPlugin{
+		Discovery: cli,
+	}
+}
+
+// Transform removes the resources of the ResMap which belong to a group version the cluster does not serve.
+// The core group is always served, and so are the group versions served by the CRDs of the ResMap, as they
+// are applied before the resources using them.
+func (p *SkipMissingGroupsPlugin) Transform(m resmap.ResMap) error {
+	groups, err := p.Discovery.ServerGroups()
+	if err != nil {
+		return fmt.Errorf("failed fetching server API groups: %w", err)
+	}
+
+	served := map[string]bool{}
+	for _, group := range groups.Groups {
+		for _, version := range group.Versions {
+			served[version.GroupVersion] = true
+		}
+	}
+	if err := addCRDGroupVersions(m, served); err != nil {
+		return err
+	}
+
+	for _, res := range m.Resources() {
+		resGvk := res.GetGvk()
+		if resGvk.Group == "" || served[resGvk.ApiVersion()] {
+			continue
+		}
+
+		ctrl.Log.Info("skipping resource of an API group version not served by the cluster",
+			"apiVersion", resGvk.ApiVersion(), "kind", resGvk.Kind, "name", res.GetName())
+		if err := m.Remove(res.CurId()); err != nil {
+			return err
+		}
+		if p.OnSkip != nil {
+			p.OnSkip(res)
+		}
+	}
+
+	return nil
+}
+
+// addCRDGroupVersions adds the group versions served by the CustomResourceDefinitions of the ResMap to served.
+func addCRDGroupVersions(m resmap.ResMap, served map[string]bool) error {
+	for _, res := range m.Resources() {
+		if res.GetGvk().Group != "apiextensions.k8s.io" || res.GetKind() != "CustomResourceDefinition" {
+			continue
+		}
+
+		group, err := res.GetString("spec.group")
+		if err != nil {
+			return fmt.Errorf("failed reading the group of CRD %s: %w", res.GetName(), err)
+		}
+		versions, err := res.GetSlice("spec.versions")
+		if err != nil {
+			return fmt.Errorf("failed reading the versions of CRD %s: %w", res.GetName(), err)
+		}
+		for _, version := range versions {
+			version, ok := version.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, ok := version["name"].(string); ok && version["served"] == true {
+				served[group+"/"+name] = true
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package plugins_test
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/kustomize/api/resource"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Skip missing groups plugin", func() {
+	It("Should drop the resources of groups which are not served", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap, `
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: managed-metrics
+`)
+
+		discoveryCli := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{GroupVersion: "v1"},
+				{GroupVersion: "apps/v1"},
+			},
+		}}
+		Expect(plugins.CreateSkipMissingGroupsPlugin(discoveryCli).Transform(resMap)).To(Succeed())
+
+		var kinds []string
+		for _, res := range resMap.Resources() {
+			kinds = append(kinds, res.GetKind())
+		}
+		Expect(kinds).To(ConsistOf("Deployment", "ConfigMap"))
+	})
+
+	It("Should keep the resources of the group versions served by the CRDs of the same set", func() {
+		resMap := newResMap(`
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: localqueues.kueue.x-k8s.io
+spec:
+  group: kueue.x-k8s.io
+  versions:
+  - name: v1beta1
+    served: true
+  - name: v1alpha1
+    served: false
+`, `
+apiVersion: kueue.x-k8s.io/v1beta1
+kind: LocalQueue
+metadata:
+  name: default
+`, `
+apiVersion: kueue.x-k8s.io/v1alpha1
+kind: LocalQueue
+metadata:
+  name: legacy
+`)
+
+		discoveryCli := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{GroupVersion: "apiextensions.k8s.io/v1"},
+			},
+		}}
+		var skipped []string
+		plugin := plugins.CreateSkipMissingGroupsPlugin(discoveryCli)
+		plugin.OnSkip = func(res *resource.Resource) {
+			skipped = append(skipped, res.GetName())
+		}
+		Expect(plugin.Transform(resMap)).To(Succeed())
+
+		var names []string
+		for _, res := range resMap.Resources() {
+			names = append(names, res.GetName())
+		}
+		Expect(names).To(ConsistOf("localqueues.kueue.x-k8s.io", "default"))
+		Expect(skipped).To(ConsistOf("legacy"))
+	})
+})
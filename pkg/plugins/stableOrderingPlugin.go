@@ -0,0 +1,43 @@
+package plugins
+
+import (
+	"cmp"
+	"slices"
+
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+)
+
+// StableOrderingPlugin sorts the resources by group, version, kind, namespace and name, so that the order in
+// which they are applied does not depend on the layout of the manifests and does not change across versions.
+type StableOrderingPlugin struct{}
+
+var _ resmap.Transformer = &StableOrderingPlugin{}
+
+// CreateStableOrderingPlugin creates a plugin sorting the resources.
+func CreateStableOrderingPlugin() *StableOrderingPlugin {
+	return &StableOrderingPlugin{}
+}
+
+// Transform sorts the resources of the ResMap.
+func (p *StableOrderingPlugin) Transform(m resmap.ResMap) error {
+	resources := m.Resources()
+	slices.SortStableFunc(resources, func(a, b *resource.Resource) int {
+		return cmp.Or(
+			cmp.Compare(a.GetGvk().Group, b.GetGvk().Group),
+			cmp.Compare(a.GetGvk().Version, b.GetGvk().Version),
+			cmp.Compare(a.GetKind(), b.GetKind()),
+			cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+			cmp.Compare(a.GetName(), b.GetName()),
+		)
+	})
+
+	m.Clear()
+	for _, res := range resources {
+		if err := m.Append(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
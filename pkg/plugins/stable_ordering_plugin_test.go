@@ -0,0 +1,36 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stable ordering plugin", func() {
+	It("Should sort the resources by group, version, kind, namespace and name", func() {
+		resMap := newResMap(
+			managedDeployment,
+			"apiVersion: v1\nkind: Service\nmetadata:\n  name: component\n  namespace: b\n",
+			componentConfigMap,
+			"apiVersion: v1\nkind: Service\nmetadata:\n  name: component\n  namespace: a\n",
+			"apiVersion: rbac.authorization.k8s.io/v1\nkind: ClusterRole\nmetadata:\n  name: component\n",
+			"apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: controller\n",
+		)
+
+		Expect(plugins.CreateStableOrderingPlugin().Transform(resMap)).To(Succeed())
+
+		ids := make([]string, 0, resMap.Size())
+		for _, res := range resMap.Resources() {
+			ids = append(ids, res.CurId().String())
+		}
+		Expect(ids).To(Equal([]string{
+			"ConfigMap.v1.[noGrp]/component-config.[noNs]",
+			"Service.v1.[noGrp]/component.a",
+			"Service.v1.[noGrp]/component.b",
+			"Deployment.v1.apps/controller.[noNs]",
+			"Deployment.v1.apps/managed.[noNs]",
+			"ClusterRole.v1.rbac.authorization.k8s.io/component.[noNs]",
+		}))
+	})
+})
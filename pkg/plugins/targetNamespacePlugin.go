@@ -0,0 +1,45 @@
+package plugins
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// TargetNamespacePlugin moves resources annotated with annotations.TargetNamespace set to
+// annotations.TargetNamespaceMonitoring into the monitoring namespace. It is meant to be applied
+// after the namespace plugin, which sets the applications namespace on all resources.
+type TargetNamespacePlugin struct {
+	MonitoringNamespace string
+}
+
+var _ resmap.Transformer = &TargetNamespacePlugin{}
+
+// CreateTargetNamespacePlugin creates a plugin routing annotated resources to the given monitoring namespace.
+func CreateTargetNamespacePlugin(monitoringNamespace string) *TargetNamespacePlugin {
+	return &TargetNamespacePlugin{
+		MonitoringNamespace: monitoringNamespace,
+	}
+}
+
+// Transform sets the monitoring namespace on the annotated namespaced resources of the ResMap.
+// Resources are left in the applications namespace when no monitoring namespace is set.
+func (p *TargetNamespacePlugin) Transform(m resmap.ResMap) error {
+	if p.MonitoringNamespace == "" {
+		return nil
+	}
+
+	for _, res := range m.Resources() {
+		if res.GetAnnotations()[annotations.TargetNamespace] != annotations.TargetNamespaceMonitoring {
+			continue
+		}
+		if res.GetGvk().IsClusterScoped() {
+			continue
+		}
+		if err := res.SetNamespace(p.MonitoringNamespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
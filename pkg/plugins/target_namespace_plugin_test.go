@@ -0,0 +1,59 @@
+package plugins_test
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Target namespace plugin", func() {
+	var resMap resmap.ResMap
+
+	BeforeEach(func() {
+		var err error
+		resMap, err = resMapFactory.NewResMapFromBytes([]byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: component-config
+---
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: component-monitor
+  annotations:
+    platform.opendatahub.io/target-namespace: monitoring
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: component
+`))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should move annotated resources to the monitoring namespace", func() {
+		Expect(plugins.CreateNamespaceApplierPlugin("opendatahub").Transform(resMap)).To(Succeed())
+		Expect(plugins.CreateTargetNamespacePlugin("opendatahub-monitoring").Transform(resMap)).To(Succeed())
+
+		for _, res := range resMap.Resources() {
+			if res.GetName() == "component-monitor" {
+				Expect(res.GetNamespace()).To(Equal("opendatahub-monitoring"))
+			} else {
+				Expect(res.GetNamespace()).To(Equal("opendatahub"))
+			}
+		}
+	})
+
+	It("Should leave annotated resources in the applications namespace without a monitoring namespace", func() {
+		Expect(plugins.CreateNamespaceApplierPlugin("opendatahub").Transform(resMap)).To(Succeed())
+		Expect(plugins.CreateTargetNamespacePlugin("").Transform(resMap)).To(Succeed())
+
+		for _, res := range resMap.Resources() {
+			Expect(res.GetNamespace()).To(Equal("opendatahub"))
+		}
+	})
+})
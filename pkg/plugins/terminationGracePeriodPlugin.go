@@ -0,0 +1,28 @@
+package plugins
+
+import (
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// TerminationGracePeriodPlugin sets "terminationGracePeriodSeconds" on the pod template of workloads which
+// do not define it, for components needing a longer graceful shutdown.
+type TerminationGracePeriodPlugin struct {
+	Seconds int64
+}
+
+var _ resmap.Transformer = &TerminationGracePeriodPlugin{}
+
+// CreateTerminationGracePeriodPlugin creates a plugin setting the given termination grace period.
+func CreateTerminationGracePeriodPlugin(seconds int64) *TerminationGracePeriodPlugin {
+	return &TerminationGracePeriodPlugin{
+		Seconds: seconds,
+	}
+}
+
+// Transform sets the termination grace period on the workloads of the ResMap where unset.
+func (p *TerminationGracePeriodPlugin) Transform(m resmap.ResMap) error {
+	return transformPodTemplates(m, nil, func(template *kyaml.RNode) error {
+		return setFieldIfUnset(template, newIntRNode(p.Seconds), "terminationGracePeriodSeconds", "spec")
+	})
+}
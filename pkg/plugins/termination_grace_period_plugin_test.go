@@ -0,0 +1,32 @@
+package plugins_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Termination grace period plugin", func() {
+	It("Should set the grace period where unset and keep the author defined one", func() {
+		resMap := newResMap(managedDeployment, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: author-defined
+spec:
+  template:
+    spec:
+      terminationGracePeriodSeconds: 30
+`, componentConfigMap)
+
+		Expect(plugins.CreateTerminationGracePeriodPlugin(120).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").GetFieldValue("spec.template.spec.terminationGracePeriodSeconds")).
+			To(Equal(120))
+		Expect(getResource(resMap, gvk.Deployment, "author-defined").GetFieldValue("spec.template.spec.terminationGracePeriodSeconds")).
+			To(Equal(30))
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").MustYaml()).To(MatchYAML(componentConfigMap))
+	})
+})
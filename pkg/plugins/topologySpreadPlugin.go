@@ -0,0 +1,43 @@
+package plugins
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// TopologySpreadPlugin adds a topology spread constraint to the pod template of workloads which do not
+// define one for the same topology key yet. The labels matched by the constraint selector are added to
+// the pod template labels, so that the constraint selects the pods of the workload it is added to.
+type TopologySpreadPlugin struct {
+	Constraint corev1.TopologySpreadConstraint
+}
+
+var _ resmap.Transformer = &TopologySpreadPlugin{}
+
+// CreateTopologySpreadPlugin creates a plugin adding the given topology spread constraint.
+func CreateTopologySpreadPlugin(constraint corev1.TopologySpreadConstraint) *TopologySpreadPlugin {
+	return &TopologySpreadPlugin{
+		Constraint: constraint,
+	}
+}
+
+// Transform adds the topology spread constraint to the workloads of the ResMap.
+func (p *TopologySpreadPlugin) Transform(m resmap.ResMap) error {
+	constraint, err := toRNode(p.Constraint)
+	if err != nil {
+		return err
+	}
+
+	return transformPodTemplates(m, nil, func(template *kyaml.RNode) error {
+		if p.Constraint.LabelSelector != nil {
+			for key, value := range p.Constraint.LabelSelector.MatchLabels {
+				if err := setFieldIfUnset(template, kyaml.NewStringRNode(value), key, "metadata", "labels"); err != nil {
+					return err
+				}
+			}
+		}
+
+		return appendIfMissing(template, constraint.Copy(), "topologyKey", "spec", "topologySpreadConstraints")
+	})
+}
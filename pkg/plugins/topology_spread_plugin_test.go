@@ -0,0 +1,61 @@
+package plugins_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Topology spread plugin", func() {
+	It("Should add the spread constraint and its selected label to the workloads", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		spreadPlugin := plugins.CreateTopologySpreadPlugin(corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app.opendatahub.io/spread": "managed"},
+			},
+		})
+		Expect(spreadPlugin.Transform(resMap)).To(Succeed())
+		// applying the plugin twice does not duplicate the constraint
+		Expect(spreadPlugin.Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.Deployment, "managed").MustYaml()).To(MatchYAML(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: managed
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: managed
+  template:
+    metadata:
+      labels:
+        app: managed
+        app.opendatahub.io/spread: managed
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.14.2
+        ports:
+        - containerPort: 80
+      topologySpreadConstraints:
+      - labelSelector:
+          matchLabels:
+            app.opendatahub.io/spread: managed
+        maxSkew: 1
+        topologyKey: topology.kubernetes.io/zone
+        whenUnsatisfiable: ScheduleAnyway
+`))
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").MustYaml()).To(MatchYAML(componentConfigMap))
+	})
+})
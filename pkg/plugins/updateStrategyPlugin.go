@@ -0,0 +1,55 @@
+package plugins
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// UpdateStrategyPlugin sets "spec/updateStrategy" on StatefulSets and DaemonSets which do not define it, e.g. a
+// rolling update with a partition for the components which must roll out carefully. A nil strategy is not set.
+type UpdateStrategyPlugin struct {
+	StatefulSet *appsv1.StatefulSetUpdateStrategy
+	DaemonSet   *appsv1.DaemonSetUpdateStrategy
+}
+
+var _ resmap.Transformer = &UpdateStrategyPlugin{}
+
+// CreateUpdateStrategyPlugin creates a plugin setting the given update strategies.
+func CreateUpdateStrategyPlugin(statefulSet *appsv1.StatefulSetUpdateStrategy,
+	daemonSet *appsv1.DaemonSetUpdateStrategy) *UpdateStrategyPlugin {
+	return &UpdateStrategyPlugin{
+		StatefulSet: statefulSet,
+		DaemonSet:   daemonSet,
+	}
+}
+
+// Transform sets the update strategies on the StatefulSets and DaemonSets of the ResMap where unset.
+func (p *UpdateStrategyPlugin) Transform(m resmap.ResMap) error {
+	strategies := map[schema.GroupVersionKind]interface{}{}
+	if p.StatefulSet != nil {
+		strategies[gvk.StatefulSet] = p.StatefulSet
+	}
+	if p.DaemonSet != nil {
+		strategies[gvk.DaemonSet] = p.DaemonSet
+	}
+
+	for kind, strategy := range strategies {
+		value, err := toRNode(strategy)
+		if err != nil {
+			return err
+		}
+
+		err = transformKinds(m, []schema.GroupVersionKind{kind}, func(node *kyaml.RNode) error {
+			return setFieldIfUnset(node, value.Copy(), "updateStrategy", "spec")
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package plugins_test
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Update strategy plugin", func() {
+	partition := int32(2)
+	statefulSetStrategy := &appsv1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+			Partition: &partition,
+		},
+	}
+
+	It("Should set the update strategy where unset and keep the author defined one", func() {
+		resMap := newResMap(`
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: managed
+`, `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: author-defined
+spec:
+  updateStrategy:
+    type: OnDelete
+`, `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+`)
+
+		Expect(plugins.CreateUpdateStrategyPlugin(statefulSetStrategy, nil).Transform(resMap)).To(Succeed())
+
+		Expect(getResource(resMap, gvk.StatefulSet, "managed").GetFieldValue("spec.updateStrategy")).To(Equal(map[string]interface{}{
+			"type":          "RollingUpdate",
+			"rollingUpdate": map[string]interface{}{"partition": 2},
+		}))
+		Expect(getResource(resMap, gvk.StatefulSet, "author-defined").GetString("spec.updateStrategy.type")).To(Equal("OnDelete"))
+		Expect(getResource(resMap, gvk.DaemonSet, "agent").GetFieldValue("spec.updateStrategy")).Error().To(HaveOccurred())
+	})
+})
@@ -0,0 +1,67 @@
+package plugins
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// VolumePlugin adds a volume to the pod template of workloads and mounts it into some of their containers,
+// e.g. an emptyDir used as scratch space. Volumes and mounts with the same name already defined are kept.
+type VolumePlugin struct {
+	Volume corev1.Volume
+	// Mounts maps the names of the containers mounting the volume to the path it is mounted at.
+	Mounts map[string]string
+	// Gvks restricts the workload kinds the volume is added to, all workloads when empty.
+	Gvks []schema.GroupVersionKind
+}
+
+var _ resmap.Transformer = &VolumePlugin{}
+
+// CreateVolumePlugin creates a plugin adding the given volume, mounted at the given paths of the named containers,
+// to the workloads of the given kinds.
+func CreateVolumePlugin(volume corev1.Volume, mounts map[string]string, gvks ...schema.GroupVersionKind) *VolumePlugin {
+	return &VolumePlugin{
+		Volume: volume,
+		Mounts: mounts,
+		Gvks:   gvks,
+	}
+}
+
+// Transform adds the volume and its mounts to the workloads of the ResMap.
+func (p *VolumePlugin) Transform(m resmap.ResMap) error {
+	volume, err := toRNode(p.Volume)
+	if err != nil {
+		return err
+	}
+
+	return transformPodTemplates(m, p.Gvks, func(template *kyaml.RNode) error {
+		if err := appendIfMissing(template, volume.Copy(), "name", "spec", "volumes"); err != nil {
+			return err
+		}
+
+		containers, err := template.Pipe(kyaml.Lookup("spec", "containers"))
+		if err != nil || containers == nil {
+			return err
+		}
+
+		return containers.VisitElements(func(container *kyaml.RNode) error {
+			name, err := container.GetString("name")
+			if err != nil {
+				return err
+			}
+			mountPath, ok := p.Mounts[name]
+			if !ok {
+				return nil
+			}
+
+			mount, err := toRNode(corev1.VolumeMount{Name: p.Volume.Name, MountPath: mountPath})
+			if err != nil {
+				return err
+			}
+
+			return appendIfMissing(container, mount, "name", "volumeMounts")
+		})
+	})
+}
@@ -0,0 +1,33 @@
+package plugins_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Volume plugin", func() {
+	It("Should add the volume and mount it into the named containers once", func() {
+		resMap := newResMap(managedDeployment, componentConfigMap)
+
+		volumePlugin := plugins.CreateVolumePlugin(corev1.Volume{
+			Name:         "scratch",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}, map[string]string{"nginx": "/tmp/scratch", "missing": "/unused"}, gvk.Deployment)
+		Expect(volumePlugin.Transform(resMap)).To(Succeed())
+		Expect(volumePlugin.Transform(resMap)).To(Succeed())
+
+		managed := getResource(resMap, gvk.Deployment, "managed")
+		Expect(managed.GetFieldValue("spec.template.spec.volumes")).To(Equal([]interface{}{
+			map[string]interface{}{"name": "scratch", "emptyDir": map[string]interface{}{}},
+		}))
+		Expect(managed.GetFieldValue("spec.template.spec.containers[0].volumeMounts")).To(Equal([]interface{}{
+			map[string]interface{}{"name": "scratch", "mountPath": "/tmp/scratch"},
+		}))
+		Expect(getResource(resMap, gvk.ConfigMap, "component-config").MustYaml()).To(MatchYAML(componentConfigMap))
+	})
+})
@@ -0,0 +1,136 @@
+package plugins
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/kustomize/api/resmap"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// appsWorkloads are the kinds from the "apps" group managing pods through their "spec/template".
+var appsWorkloads = []schema.GroupVersionKind{
+	gvk.Deployment,
+	gvk.StatefulSet,
+	gvk.DaemonSet,
+}
+
+// podTemplatePaths maps the workload kinds to the path of their pod template.
+var podTemplatePaths = map[schema.GroupVersionKind][]string{
+	gvk.Deployment:  {"spec", "template"},
+	gvk.StatefulSet: {"spec", "template"},
+	gvk.DaemonSet:   {"spec", "template"},
+	gvk.Job:         {"spec", "template"},
+	gvk.CronJob:     {"spec", "jobTemplate", "spec", "template"},
+}
+
+// transformKinds calls fn on every resource of the ResMap matching one of the given kinds.
+func transformKinds(m resmap.ResMap, gvks []schema.GroupVersionKind, fn func(node *kyaml.RNode) error) error {
+	for _, res := range m.Resources() {
+		if !slices.Contains(gvks, resourceGvk(res.GetGvk().Group, res.GetGvk().Version, res.GetGvk().Kind)) {
+			continue
+		}
+		if err := fn(&res.RNode); err != nil {
+			return fmt.Errorf("failed transforming %s: %w", res.CurId(), err)
+		}
+	}
+
+	return nil
+}
+
+// transformPodTemplates calls fn on the pod template of every workload of the ResMap matching one of the given
+// kinds, or of every workload when no kind is given. The pod template is created if missing.
+func transformPodTemplates(m resmap.ResMap, gvks []schema.GroupVersionKind, fn func(template *kyaml.RNode) error) error {
+	for _, res := range m.Resources() {
+		resGvk := resourceGvk(res.GetGvk().Group, res.GetGvk().Version, res.GetGvk().Kind)
+		path, isWorkload := podTemplatePaths[resGvk]
+		if !isWorkload || (len(gvks) > 0 && !slices.Contains(gvks, resGvk)) {
+			continue
+		}
+
+		template, err := res.Pipe(kyaml.LookupCreate(kyaml.MappingNode, path...))
+		if err != nil {
+			return fmt.Errorf("failed looking up pod template of %s: %w", res.CurId(), err)
+		}
+		if err := fn(template); err != nil {
+			return fmt.Errorf("failed transforming pod template of %s: %w", res.CurId(), err)
+		}
+	}
+
+	return nil
+}
+
+// transformContainers calls fn on every container of the pod template of the workloads of the ResMap matching
+// one of the given kinds, or of every workload when no kind is given. Init containers are excluded.
+func transformContainers(m resmap.ResMap, gvks []schema.GroupVersionKind, fn func(container *kyaml.RNode) error) error {
+	return transformPodTemplates(m, gvks, func(template *kyaml.RNode) error {
+		containers, err := template.Pipe(kyaml.Lookup("spec", "containers"))
+		if err != nil || containers == nil {
+			return err
+		}
+
+		return containers.VisitElements(fn)
+	})
+}
+
+func resourceGvk(group, version, kind string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: group, Version: version, Kind: kind}
+}
+
+// setFieldIfUnset sets the field 'name' of the mapping at 'path' to value, creating the mapping when missing.
+// Fields which are already set are left untouched.
+func setFieldIfUnset(node *kyaml.RNode, value *kyaml.RNode, name string, path ...string) error {
+	parent, err := node.Pipe(kyaml.LookupCreate(kyaml.MappingNode, path...))
+	if err != nil {
+		return err
+	}
+	if field := parent.Field(name); field != nil && !kyaml.IsMissingOrNull(field.Value) {
+		return nil
+	}
+
+	return parent.PipeE(kyaml.SetField(name, value))
+}
+
+// appendIfMissing appends elem to the sequence at 'path', creating it when missing, unless the sequence
+// already holds an element with the same value for the field 'key', e.g. a container with the same name.
+func appendIfMissing(node *kyaml.RNode, elem *kyaml.RNode, key string, path ...string) error {
+	list, err := node.Pipe(kyaml.LookupCreate(kyaml.SequenceNode, path...))
+	if err != nil {
+		return err
+	}
+
+	if keyField := elem.Field(key); keyField != nil {
+		existing, err := list.Pipe(kyaml.MatchElement(key, kyaml.GetValue(keyField.Value)))
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return nil
+		}
+	}
+
+	return list.PipeE(kyaml.Append(elem.YNode()))
+}
+
+// newIntRNode creates a scalar node holding an integer, tagged as such so that it is not rendered as a string.
+func newIntRNode(value int64) *kyaml.RNode {
+	node := kyaml.NewScalarRNode(strconv.FormatInt(value, 10))
+	node.YNode().Tag = kyaml.NodeTagInt
+
+	return node
+}
+
+// toRNode converts a Kubernetes API object, e.g. a corev1.Container, to a node which can be set into resources.
+func toRNode(obj interface{}) (*kyaml.RNode, error) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return kyaml.Parse(string(data))
+}
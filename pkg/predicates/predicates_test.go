@@ -0,0 +1,50 @@
+package predicates_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/predicates"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIgnoreStatusUpdates(t *testing.T) {
+	g := NewWithT(t)
+
+	oldDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "component",
+			Namespace:  "opendatahub",
+			Generation: 1,
+		},
+	}
+
+	statusUpdate := oldDeployment.DeepCopy()
+	statusUpdate.Status.AvailableReplicas = 1
+
+	g.Expect(predicates.IgnoreStatusUpdates.Update(event.UpdateEvent{
+		ObjectOld: oldDeployment,
+		ObjectNew: statusUpdate,
+	})).To(BeFalse())
+
+	specUpdate := oldDeployment.DeepCopy()
+	specUpdate.Generation = 2
+	specUpdate.Spec.Paused = true
+
+	g.Expect(predicates.IgnoreStatusUpdates.Update(event.UpdateEvent{
+		ObjectOld: oldDeployment,
+		ObjectNew: specUpdate,
+	})).To(BeTrue())
+
+	labelsUpdate := oldDeployment.DeepCopy()
+	labelsUpdate.Labels = map[string]string{"team": "ml"}
+
+	g.Expect(predicates.IgnoreStatusUpdates.Update(event.UpdateEvent{
+		ObjectOld: oldDeployment,
+		ObjectNew: labelsUpdate,
+	})).To(BeTrue())
+}
@@ -66,12 +66,8 @@ func OperatorUninstall(ctx context.Context, cli client.Client, platform cluster.
 	}
 
 	ctrl.Log.Info("Removing operator subscription which in turn will remove installplan")
-	subsName := "opendatahub-operator"
-	if platform == cluster.SelfManagedRhoai {
-		subsName = "rhods-operator"
-	}
 	if platform != cluster.ManagedRhoai {
-		if err := cluster.DeleteExistingSubscription(ctx, cli, operatorNs, subsName); err != nil {
+		if err := cluster.DeleteExistingSubscription(ctx, cli, operatorNs, cluster.OperatorName(platform)); err != nil {
 			return err
 		}
 	}